@@ -0,0 +1,57 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package app
+
+import (
+	"context"
+	"sync"
+
+	"github.com/z5labs/bedrock"
+)
+
+// FromActor adapts an actor, as used by github.com/oklog/run, into a [bedrock.App].
+// The returned [bedrock.App] calls execute and, if the [context.Context] passed to
+// its Run method is cancelled before execute returns, calls interrupt with the
+// [context.Context] error. Interrupt is never called after Run has returned.
+func FromActor(execute func() error, interrupt func(error)) bedrock.App {
+	return runFunc(func(ctx context.Context) error {
+		done := make(chan struct{})
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			select {
+			case <-done:
+			case <-ctx.Done():
+				interrupt(ctx.Err())
+			}
+		}()
+
+		// Deferred so the watcher is also stopped if execute panics.
+		defer func() {
+			close(done)
+			wg.Wait()
+		}()
+
+		return execute()
+	})
+}
+
+// ToActor adapts a [bedrock.App] into an actor, as used by github.com/oklog/run.
+// Execute runs the app with a [context.Context] derived from the given one
+// and interrupt cancels that [context.Context].
+func ToActor(ctx context.Context, app bedrock.App) (execute func() error, interrupt func(error)) {
+	ctx, cancel := context.WithCancel(ctx)
+	execute = func() error {
+		return app.Run(ctx)
+	}
+	interrupt = func(error) {
+		cancel()
+	}
+	return execute, interrupt
+}
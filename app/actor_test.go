@@ -0,0 +1,173 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/z5labs/bedrock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromActor(t *testing.T) {
+	t.Run("will call interrupt", func(t *testing.T) {
+		t.Run("if the context is cancelled before Run is called", func(t *testing.T) {
+			stop := make(chan struct{})
+			var interruptErr error
+			app := FromActor(
+				func() error {
+					<-stop
+					return nil
+				},
+				func(err error) {
+					interruptErr = err
+					close(stop)
+				},
+			)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			err := app.Run(ctx)
+			if !assert.Nil(t, err) {
+				return
+			}
+			if !assert.ErrorIs(t, interruptErr, context.Canceled) {
+				return
+			}
+		})
+
+		t.Run("if the context is cancelled while execute is running", func(t *testing.T) {
+			errInterrupted := errors.New("interrupted")
+			started := make(chan struct{})
+			stop := make(chan struct{})
+			app := FromActor(
+				func() error {
+					close(started)
+					<-stop
+					return errInterrupted
+				},
+				func(err error) {
+					close(stop)
+				},
+			)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			errCh := make(chan error, 1)
+			go func() {
+				errCh <- app.Run(ctx)
+			}()
+
+			<-started
+			cancel()
+
+			err := <-errCh
+			if !assert.ErrorIs(t, err, errInterrupted) {
+				return
+			}
+		})
+	})
+
+	t.Run("will not call interrupt", func(t *testing.T) {
+		t.Run("if execute returns before the context is cancelled", func(t *testing.T) {
+			execErr := errors.New("failed to execute")
+			interrupted := false
+			app := FromActor(
+				func() error {
+					return execErr
+				},
+				func(err error) {
+					interrupted = true
+				},
+			)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			err := app.Run(ctx)
+			cancel()
+
+			if !assert.ErrorIs(t, err, execErr) {
+				return
+			}
+			if !assert.False(t, interrupted) {
+				return
+			}
+		})
+
+		t.Run("if the context is cancelled after execute panics", func(t *testing.T) {
+			interrupted := make(chan struct{}, 1)
+			app := Recover(FromActor(
+				func() error {
+					panic("hello world")
+				},
+				func(err error) {
+					interrupted <- struct{}{}
+				},
+			))
+
+			ctx, cancel := context.WithCancel(context.Background())
+			err := app.Run(ctx)
+			cancel()
+
+			var perr bedrock.PanicError
+			if !assert.ErrorAs(t, err, &perr) {
+				return
+			}
+
+			select {
+			case <-interrupted:
+				assert.Fail(t, "interrupt should not be called after Run has returned")
+			case <-time.After(20 * time.Millisecond):
+			}
+		})
+	})
+}
+
+func TestToActor(t *testing.T) {
+	t.Run("will cancel the app context", func(t *testing.T) {
+		t.Run("if interrupt is called", func(t *testing.T) {
+			started := make(chan struct{})
+			execute, interrupt := ToActor(context.Background(), runFunc(func(ctx context.Context) error {
+				close(started)
+				<-ctx.Done()
+				return ctx.Err()
+			}))
+
+			errCh := make(chan error, 1)
+			go func() {
+				errCh <- execute()
+			}()
+
+			<-started
+			interrupt(errors.New("stop"))
+
+			err := <-errCh
+			if !assert.ErrorIs(t, err, context.Canceled) {
+				return
+			}
+		})
+	})
+
+	t.Run("will return the app error", func(t *testing.T) {
+		t.Run("if the app completes on its own", func(t *testing.T) {
+			appErr := errors.New("failed to run")
+			execute, interrupt := ToActor(context.Background(), runFunc(func(ctx context.Context) error {
+				return appErr
+			}))
+			defer interrupt(nil)
+
+			err := execute()
+			if !assert.ErrorIs(t, err, appErr) {
+				return
+			}
+		})
+	})
+}
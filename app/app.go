@@ -9,10 +9,16 @@ package app
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
 	"os/signal"
+	"runtime/debug"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com/z5labs/bedrock"
+	"github.com/z5labs/bedrock/internal/async"
 )
 
 type runFunc func(context.Context) error
@@ -21,10 +27,13 @@ func (f runFunc) Run(ctx context.Context) error {
 	return f(ctx)
 }
 
-// Recover will wrap the give [bedrock.App] with panic recovery.
-// If the recovered panic value implements [error] then it will
-// be directly returned. If it does not implement [error] then a
-// [PanicError] will be returned instead.
+// Recover will wrap the given [bedrock.App] with panic recovery.
+// The recovered panic value is always returned as a [bedrock.PanicError],
+// even if it implements [error], so callers can distinguish a panic from
+// a returned error. If the value does implement [error] then it can still
+// be matched with [errors.Is] and [errors.As] via [bedrock.PanicError.Unwrap].
+// Calls to [runtime.Goexit] are not recovered and will still terminate the
+// calling goroutine.
 func Recover(app bedrock.App) bedrock.App {
 	return runFunc(func(ctx context.Context) (err error) {
 		defer bedrock.Recover(&err)
@@ -36,6 +45,13 @@ func Recover(app bedrock.App) bedrock.App {
 // WithSignalNotifications wraps a given [bedrock.App] in an implementation
 // that cancels the [context.Context] that's passed to app.Run if an [os.Signal]
 // is received by the running process.
+//
+// Only the given signals cancel the [context.Context]. Always pass explicit signals,
+// e.g. [os.Interrupt] and [syscall.SIGTERM]. As with [signal.NotifyContext], passing
+// no signals relays every incoming signal, including the SIGURG signals the Go runtime
+// uses internally for goroutine preemption, so the app may be cancelled at random.
+// To disable signal handling entirely, simply do not wrap the [bedrock.App] with
+// WithSignalNotifications.
 func WithSignalNotifications(app bedrock.App, signals ...os.Signal) bedrock.App {
 	return runFunc(func(ctx context.Context) error {
 		sigCtx, cancel := signal.NotifyContext(ctx, signals...)
@@ -45,6 +61,70 @@ func WithSignalNotifications(app bedrock.App, signals ...os.Signal) bedrock.App
 	})
 }
 
+// ErrForcedExit is returned by an app wrapped with [WithForceExitOnSecondSignal]
+// if a second signal is received before the app has shutdown.
+var ErrForcedExit = errors.New("forced exit due to repeated signal")
+
+type signalNotifier struct {
+	notify func(chan<- os.Signal, ...os.Signal)
+	stop   func(chan<- os.Signal)
+}
+
+var osSignalNotifier = signalNotifier{
+	notify: signal.Notify,
+	stop:   signal.Stop,
+}
+
+// WithForceExitOnSecondSignal wraps a given [bedrock.App] in an implementation that,
+// like [WithSignalNotifications], cancels the [context.Context] that's passed to app.Run
+// when the first [os.Signal] is received. If a second signal is received before app.Run
+// has returned then graceful shutdown is abandoned and [ErrForcedExit] is immediately
+// returned, leaving app.Run to finish in the background.
+//
+// If no signals are given then [os.Interrupt] and [syscall.SIGTERM] are used
+// instead of relaying every incoming signal, since the Go runtime internally
+// sends SIGURG for goroutine preemption which would trigger a forced exit.
+//
+// Because app.Run may be abandoned on a second signal, it is executed in its own
+// goroutine and any panic it raises is returned as a [bedrock.PanicError] instead
+// of crashing the process.
+func WithForceExitOnSecondSignal(app bedrock.App, signals ...os.Signal) bedrock.App {
+	return withForceExitOnSecondSignal(app, osSignalNotifier, signals...)
+}
+
+func withForceExitOnSecondSignal(app bedrock.App, notifier signalNotifier, signals ...os.Signal) bedrock.App {
+	if len(signals) == 0 {
+		signals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+	}
+
+	return runFunc(func(ctx context.Context) error {
+		sigCh := make(chan os.Signal, 2)
+		notifier.notify(sigCh, signals...)
+		defer notifier.stop(sigCh)
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		errCh := async.Go(func() error {
+			return app.Run(ctx)
+		})
+
+		received := 0
+		for {
+			select {
+			case err := <-errCh:
+				return err
+			case <-sigCh:
+				received++
+				if received > 1 {
+					return ErrForcedExit
+				}
+				cancel()
+			}
+		}
+	})
+}
+
 // LifecycleHook represents functionality that needs to be performed
 // at a specific "time" relative to the execution of [bedrock.App.Run].
 type LifecycleHook interface {
@@ -81,11 +161,109 @@ func ComposeLifecycleHooks(hooks ...LifecycleHook) LifecycleHook {
 	})
 }
 
-// Lifecycle
+// ComposeLifecycleHooksConcurrently combines multiple [LifecycleHook]s into
+// a single hook which runs each of them concurrently. All hooks are always ran
+// to completion and any and all errors are then returned, in the order the hooks
+// were given. Hooks which depend on each other should instead be combined
+// with [ComposeLifecycleHooks].
+//
+// A panic in one hook does not stop the others; it is returned as a
+// [bedrock.PanicError] alongside any other hook errors.
+func ComposeLifecycleHooksConcurrently(hooks ...LifecycleHook) LifecycleHook {
+	return ComposeLifecycleHooksConcurrentlyN(0, hooks...)
+}
+
+// ComposeLifecycleHooksConcurrentlyN is like [ComposeLifecycleHooksConcurrently]
+// except at most limit hooks are ran at the same time, e.g. to avoid overwhelming
+// a shared backend. A limit of zero, or less, means no limit.
+func ComposeLifecycleHooksConcurrentlyN(limit int, hooks ...LifecycleHook) LifecycleHook {
+	return LifecycleHookFunc(func(ctx context.Context) error {
+		errs := make([]error, len(hooks))
+
+		var sem chan struct{}
+		if limit > 0 {
+			sem = make(chan struct{}, limit)
+		}
+
+		var wg sync.WaitGroup
+		for i, hook := range hooks {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if sem != nil {
+					sem <- struct{}{}
+					defer func() { <-sem }()
+				}
+				defer bedrock.Recover(&errs[i])
+
+				errs[i] = hook.Run(ctx)
+			}()
+		}
+		wg.Wait()
+
+		// errors.Join discards nil errors and returns
+		// nil if all of the errors are nil.
+		return errors.Join(errs...)
+	})
+}
+
+// LifecycleHookError occurs when a [LifecycleHook] registered
+// with [NamedLifecycleHook] returns an error.
+type LifecycleHookError struct {
+	Name  string
+	Cause error
+}
+
+// Error implements the error interface.
+func (e LifecycleHookError) Error() string {
+	return fmt.Sprintf("lifecycle hook %s failed: %s", e.Name, e.Cause)
+}
+
+// Unwrap implements the implicit interface used by errors.Is and errors.As.
+func (e LifecycleHookError) Unwrap() error {
+	return e.Cause
+}
+
+// NamedLifecycleHook wraps the given [LifecycleHook] such that any error it
+// returns is wrapped in a [LifecycleHookError] with the given name. This makes
+// it possible to tell which hook failed when multiple hooks are combined
+// with [ComposeLifecycleHooks].
+func NamedLifecycleHook(name string, hook LifecycleHook) LifecycleHook {
+	return LifecycleHookFunc(func(ctx context.Context) error {
+		err := hook.Run(ctx)
+		if err == nil {
+			return nil
+		}
+		return LifecycleHookError{Name: name, Cause: err}
+	})
+}
+
+// Lifecycle is the primary extension point for running functionality around
+// the execution of a [bedrock.App]. It is applied with [WithLifecycleHooks]
+// and its hooks are executed in the following order:
+//
+//  1. PreRun
+//  2. [bedrock.App.Run], only if PreRun succeeded
+//  3. PostRun
+//
+// Both hooks are optional and multiple hooks can be combined into a
+// single phase with [ComposeLifecycleHooks].
 type Lifecycle struct {
-	// PostRun is always executed regardless if the underlying [bedrock.App]
-	// returns an error or panics.
+	// PreRun is executed before the underlying [bedrock.App]. If it returns
+	// an error then the [bedrock.App] is not ran.
+	PreRun LifecycleHook
+
+	// PostRun is always executed regardless if the PreRun hook or
+	// the underlying [bedrock.App] returns an error or panics. The
+	// returned error can be retrieved with [RunErrorFromContext]. Its
+	// [context.Context] is not cancelled, even if the one passed to
+	// the [bedrock.App] was, but still carries all of its values.
 	PostRun LifecycleHook
+
+	// PostRunTimeout bounds how long the PostRun hook may take by
+	// cancelling its [context.Context] once elapsed. A zero timeout
+	// means the PostRun hook is never cancelled.
+	PostRunTimeout time.Duration
 }
 
 // WithLifecycleHooks wraps a given [bedrock.App] in an implementation
@@ -93,18 +271,61 @@ type Lifecycle struct {
 func WithLifecycleHooks(app bedrock.App, lifecycle Lifecycle) bedrock.App {
 	return runFunc(func(ctx context.Context) (err error) {
 		// Always run PostRun hook regardless if app returns an error or panics.
-		defer runPostRunHook(ctx, lifecycle.PostRun, &err)
+		defer func() {
+			r := recover()
+			runPostRunHook(ctx, lifecycle, &err, r)
+			if r != nil {
+				// The PostRun hook only observes the panic, it does not handle it.
+				panic(r)
+			}
+		}()
+
+		if lifecycle.PreRun != nil {
+			err = lifecycle.PreRun.Run(ctx)
+			if err != nil {
+				return err
+			}
+		}
 
 		return app.Run(ctx)
 	})
 }
 
-func runPostRunHook(ctx context.Context, hook LifecycleHook, err *error) {
-	if hook == nil {
+type runErrorKey struct{}
+
+// RunErrorFromContext returns the error, if any, returned by the PreRun hook or
+// the underlying [bedrock.App] wrapped by [WithLifecycleHooks]. It is only
+// available to the [Lifecycle.PostRun] hook and allows it to tell whether
+// the app exited cleanly or not. If the app panicked, the error is a
+// [bedrock.PanicError] and the panic continues once PostRun returns.
+func RunErrorFromContext(ctx context.Context) error {
+	err, _ := ctx.Value(runErrorKey{}).(error)
+	return err
+}
+
+func runPostRunHook(ctx context.Context, lifecycle Lifecycle, err *error, panicValue any) {
+	if lifecycle.PostRun == nil {
 		return
 	}
 
-	hookErr := hook.Run(ctx)
+	runErr := *err
+	if panicValue != nil {
+		runErr = bedrock.PanicError{
+			Value: panicValue,
+			Stack: debug.Stack(),
+		}
+	}
+
+	// The run context has most likely been cancelled by now, e.g. due to
+	// a signal, so detach from it to allow the hook to still perform I/O.
+	ctx = context.WithoutCancel(ctx)
+	if lifecycle.PostRunTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, lifecycle.PostRunTimeout)
+		defer cancel()
+	}
+	ctx = context.WithValue(ctx, runErrorKey{}, runErr)
+	hookErr := lifecycle.PostRun.Run(ctx)
 
 	// errors.Join will not return an error if both
 	// *err and hookErr are nil.
@@ -16,7 +16,6 @@ import (
 func ExampleRecover() {
 	app := runFunc(func(ctx context.Context) error {
 		panic("hello world")
-		return nil
 	})
 
 	err := Recover(app).Run(context.Background())
@@ -34,7 +33,6 @@ func ExampleRecover() {
 func ExampleRecover_errorValue() {
 	app := runFunc(func(ctx context.Context) error {
 		panic(errors.New("hello world"))
-		return nil
 	})
 
 	err := Recover(app).Run(context.Background())
@@ -72,10 +70,37 @@ func ExampleWithLifecycleHooks() {
 	// Output: ran post run hook
 }
 
+func ExampleWithLifecycleHooks_preRun() {
+	var app bedrock.App = runFunc(func(ctx context.Context) error {
+		fmt.Println("ran app")
+		return nil
+	})
+
+	app = WithLifecycleHooks(app, Lifecycle{
+		PreRun: LifecycleHookFunc(func(ctx context.Context) error {
+			fmt.Println("ran pre run hook")
+			return nil
+		}),
+		PostRun: LifecycleHookFunc(func(ctx context.Context) error {
+			fmt.Println("ran post run hook")
+			return nil
+		}),
+	})
+
+	err := app.Run(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	// Output: ran pre run hook
+	// ran app
+	// ran post run hook
+}
+
 func ExampleWithLifecycleHooks_unrecoveredPanic() {
 	var app bedrock.App = runFunc(func(ctx context.Context) error {
 		panic("hello world")
-		return nil
 	})
 
 	postRun := LifecycleHookFunc(func(ctx context.Context) error {
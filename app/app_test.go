@@ -8,7 +8,11 @@ package app
 import (
 	"context"
 	"errors"
+	"os"
+	"sync"
+	"syscall"
 	"testing"
+	"time"
 
 	"github.com/z5labs/bedrock"
 
@@ -33,19 +37,22 @@ func TestRecover(t *testing.T) {
 			appErr := errors.New("failed to run")
 			app := Recover(runFunc(func(ctx context.Context) error {
 				panic(appErr)
-				return nil
 			}))
 
 			err := app.Run(context.Background())
 			if !assert.ErrorIs(t, err, appErr) {
 				return
 			}
+
+			var perr bedrock.PanicError
+			if !assert.ErrorAs(t, err, &perr) {
+				return
+			}
 		})
 
 		t.Run("if the underlying App panics with a non-error value", func(t *testing.T) {
 			app := Recover(runFunc(func(ctx context.Context) error {
 				panic("hello world")
-				return nil
 			}))
 
 			err := app.Run(context.Background())
@@ -83,6 +90,115 @@ func TestWithSignalNotifications(t *testing.T) {
 	})
 }
 
+type fakeSignalNotifier struct {
+	notified chan chan<- os.Signal
+	signals  []os.Signal
+}
+
+func newFakeSignalNotifier() *fakeSignalNotifier {
+	return &fakeSignalNotifier{
+		notified: make(chan chan<- os.Signal, 1),
+	}
+}
+
+func (n *fakeSignalNotifier) notifier() signalNotifier {
+	return signalNotifier{
+		notify: func(c chan<- os.Signal, s ...os.Signal) {
+			n.signals = s
+			n.notified <- c
+		},
+		stop: func(c chan<- os.Signal) {},
+	}
+}
+
+func TestWithForceExitOnSecondSignal(t *testing.T) {
+	t.Run("will return an error", func(t *testing.T) {
+		t.Run("if a second signal is received before the app returns", func(t *testing.T) {
+			release := make(chan struct{})
+			defer close(release)
+
+			cancelled := make(chan struct{})
+			n := newFakeSignalNotifier()
+			app := withForceExitOnSecondSignal(runFunc(func(ctx context.Context) error {
+				<-ctx.Done()
+				close(cancelled)
+				<-release
+				return nil
+			}), n.notifier(), os.Interrupt)
+
+			errCh := make(chan error, 1)
+			go func() {
+				errCh <- app.Run(context.Background())
+			}()
+
+			sigCh := <-n.notified
+			sigCh <- os.Interrupt
+			<-cancelled
+			sigCh <- os.Interrupt
+
+			err := <-errCh
+			if !assert.ErrorIs(t, err, ErrForcedExit) {
+				return
+			}
+		})
+
+		t.Run("if the app panics", func(t *testing.T) {
+			n := newFakeSignalNotifier()
+			app := withForceExitOnSecondSignal(runFunc(func(ctx context.Context) error {
+				panic("hello world")
+			}), n.notifier(), os.Interrupt)
+
+			err := app.Run(context.Background())
+
+			var perr bedrock.PanicError
+			if !assert.ErrorAs(t, err, &perr) {
+				return
+			}
+		})
+	})
+
+	t.Run("will only be notified of os.Interrupt and syscall.SIGTERM", func(t *testing.T) {
+		t.Run("if no signals are given", func(t *testing.T) {
+			n := newFakeSignalNotifier()
+			app := withForceExitOnSecondSignal(runFunc(func(ctx context.Context) error {
+				return nil
+			}), n.notifier())
+
+			err := app.Run(context.Background())
+			if !assert.Nil(t, err) {
+				return
+			}
+			<-n.notified
+			if !assert.Equal(t, []os.Signal{os.Interrupt, syscall.SIGTERM}, n.signals) {
+				return
+			}
+		})
+	})
+
+	t.Run("will gracefully shutdown", func(t *testing.T) {
+		t.Run("if only a single signal is received", func(t *testing.T) {
+			n := newFakeSignalNotifier()
+			app := withForceExitOnSecondSignal(runFunc(func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}), n.notifier(), os.Interrupt)
+
+			errCh := make(chan error, 1)
+			go func() {
+				errCh <- app.Run(context.Background())
+			}()
+
+			sigCh := <-n.notified
+			sigCh <- os.Interrupt
+
+			err := <-errCh
+			if !assert.ErrorIs(t, err, context.Canceled) {
+				return
+			}
+		})
+	})
+}
+
 func TestWithLifecycleHooks(t *testing.T) {
 	t.Run("will return error", func(t *testing.T) {
 		t.Run("if the underlying app fails", func(t *testing.T) {
@@ -99,6 +215,41 @@ func TestWithLifecycleHooks(t *testing.T) {
 			}
 		})
 
+		t.Run("if the Lifecycle.PreRun hook fails", func(t *testing.T) {
+			ran := false
+			base := runFunc(func(ctx context.Context) error {
+				ran = true
+				return nil
+			})
+
+			preRunErr := errors.New("failed to pre run")
+			preRun := LifecycleHookFunc(func(ctx context.Context) error {
+				return preRunErr
+			})
+
+			postRan := false
+			postRun := LifecycleHookFunc(func(ctx context.Context) error {
+				postRan = true
+				return nil
+			})
+
+			app := WithLifecycleHooks(base, Lifecycle{
+				PreRun:  preRun,
+				PostRun: postRun,
+			})
+
+			err := app.Run(context.Background())
+			if !assert.ErrorIs(t, err, preRunErr) {
+				return
+			}
+			if !assert.False(t, ran) {
+				return
+			}
+			if !assert.True(t, postRan) {
+				return
+			}
+		})
+
 		t.Run("if the Lifecycle.PostRun hook fails", func(t *testing.T) {
 			base := runFunc(func(ctx context.Context) error {
 				return nil
@@ -144,6 +295,176 @@ func TestWithLifecycleHooks(t *testing.T) {
 		})
 	})
 
+	t.Run("will run hooks in order", func(t *testing.T) {
+		t.Run("if all phases succeed", func(t *testing.T) {
+			var order []string
+			hook := func(name string) LifecycleHookFunc {
+				return func(ctx context.Context) error {
+					order = append(order, name)
+					return nil
+				}
+			}
+
+			app := WithLifecycleHooks(runFunc(hook("run")), Lifecycle{
+				PreRun:  hook("pre run"),
+				PostRun: hook("post run"),
+			})
+
+			err := app.Run(context.Background())
+			if !assert.Nil(t, err) {
+				return
+			}
+			if !assert.Equal(t, []string{"pre run", "run", "post run"}, order) {
+				return
+			}
+		})
+	})
+
+	t.Run("will provide a non-cancelled context to the Lifecycle.PostRun hook", func(t *testing.T) {
+		t.Run("if the context passed to the app was cancelled", func(t *testing.T) {
+			type ctxKey struct{}
+
+			base := runFunc(func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			})
+
+			var (
+				postRunErr error
+				postRunVal any
+			)
+			postRun := LifecycleHookFunc(func(ctx context.Context) error {
+				postRunErr = ctx.Err()
+				postRunVal = ctx.Value(ctxKey{})
+				return nil
+			})
+
+			app := WithLifecycleHooks(base, Lifecycle{
+				PostRun: postRun,
+			})
+
+			ctx, cancel := context.WithCancel(context.WithValue(context.Background(), ctxKey{}, "value"))
+			cancel()
+
+			err := app.Run(ctx)
+			if !assert.ErrorIs(t, err, context.Canceled) {
+				return
+			}
+			if !assert.Nil(t, postRunErr) {
+				return
+			}
+			if !assert.Equal(t, "value", postRunVal) {
+				return
+			}
+		})
+	})
+
+	t.Run("will cancel the Lifecycle.PostRun hook", func(t *testing.T) {
+		t.Run("if it does not return before the Lifecycle.PostRunTimeout", func(t *testing.T) {
+			base := runFunc(func(ctx context.Context) error {
+				return nil
+			})
+
+			postRun := LifecycleHookFunc(func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			})
+
+			app := WithLifecycleHooks(base, Lifecycle{
+				PostRun:        postRun,
+				PostRunTimeout: 10 * time.Millisecond,
+			})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			err := app.Run(ctx)
+			if !assert.ErrorIs(t, err, context.DeadlineExceeded) {
+				return
+			}
+		})
+	})
+
+	t.Run("will provide the run error to the Lifecycle.PostRun hook", func(t *testing.T) {
+		t.Run("if the underlying app fails", func(t *testing.T) {
+			baseErr := errors.New("failed to run app")
+			base := runFunc(func(ctx context.Context) error {
+				return baseErr
+			})
+
+			var runErr error
+			postRun := LifecycleHookFunc(func(ctx context.Context) error {
+				runErr = RunErrorFromContext(ctx)
+				return nil
+			})
+
+			app := WithLifecycleHooks(base, Lifecycle{
+				PostRun: postRun,
+			})
+
+			err := app.Run(context.Background())
+			if !assert.ErrorIs(t, err, baseErr) {
+				return
+			}
+			if !assert.Equal(t, baseErr, runErr) {
+				return
+			}
+		})
+
+		t.Run("if the underlying app panics", func(t *testing.T) {
+			base := runFunc(func(ctx context.Context) error {
+				panic("hello world")
+			})
+
+			var runErr error
+			postRun := LifecycleHookFunc(func(ctx context.Context) error {
+				runErr = RunErrorFromContext(ctx)
+				return nil
+			})
+
+			app := Recover(WithLifecycleHooks(base, Lifecycle{
+				PostRun: postRun,
+			}))
+
+			err := app.Run(context.Background())
+
+			var perr bedrock.PanicError
+			if !assert.ErrorAs(t, err, &perr) {
+				return
+			}
+			if !assert.ErrorAs(t, runErr, &perr) {
+				return
+			}
+			if !assert.Equal(t, "hello world", perr.Value) {
+				return
+			}
+		})
+
+		t.Run("if the underlying app succeeds", func(t *testing.T) {
+			base := runFunc(func(ctx context.Context) error {
+				return nil
+			})
+
+			runErr := errors.New("unexpected")
+			postRun := LifecycleHookFunc(func(ctx context.Context) error {
+				runErr = RunErrorFromContext(ctx)
+				return nil
+			})
+
+			app := WithLifecycleHooks(base, Lifecycle{
+				PostRun: postRun,
+			})
+
+			err := app.Run(context.Background())
+			if !assert.Nil(t, err) {
+				return
+			}
+			if !assert.Nil(t, runErr) {
+				return
+			}
+		})
+	})
+
 	t.Run("will not return an error", func(t *testing.T) {
 		t.Run("if both the underlying app and the Lifecycle.PostRun do not fail", func(t *testing.T) {
 			base := runFunc(func(ctx context.Context) error {
@@ -219,3 +540,173 @@ func TestComposeLifecycleHooks(t *testing.T) {
 		})
 	})
 }
+
+func TestNamedLifecycleHook(t *testing.T) {
+	t.Run("will return an error", func(t *testing.T) {
+		t.Run("if the underlying hook fails", func(t *testing.T) {
+			errHookFailed := errors.New("failed to run hook")
+
+			hook := ComposeLifecycleHooks(
+				NamedLifecycleHook("first", LifecycleHookFunc(func(ctx context.Context) error {
+					return nil
+				})),
+				NamedLifecycleHook("second", LifecycleHookFunc(func(ctx context.Context) error {
+					return errHookFailed
+				})),
+			)
+
+			err := hook.Run(context.Background())
+			if !assert.ErrorIs(t, err, errHookFailed) {
+				return
+			}
+
+			var herr LifecycleHookError
+			if !assert.ErrorAs(t, err, &herr) {
+				return
+			}
+			if !assert.NotEmpty(t, herr.Error()) {
+				return
+			}
+			if !assert.Equal(t, "second", herr.Name) {
+				return
+			}
+		})
+	})
+
+	t.Run("will not return an error", func(t *testing.T) {
+		t.Run("if the underlying hook succeeds", func(t *testing.T) {
+			hook := NamedLifecycleHook("hook", LifecycleHookFunc(func(ctx context.Context) error {
+				return nil
+			}))
+
+			err := hook.Run(context.Background())
+			if !assert.Nil(t, err) {
+				return
+			}
+		})
+	})
+}
+
+func TestComposeLifecycleHooksConcurrently(t *testing.T) {
+	t.Run("will return an error", func(t *testing.T) {
+		t.Run("if multiple lifecycle hooks fail", func(t *testing.T) {
+			errFirst := errors.New("first hook failed")
+			errSecond := errors.New("second hook failed")
+
+			hook := ComposeLifecycleHooksConcurrently(
+				LifecycleHookFunc(func(ctx context.Context) error {
+					return errFirst
+				}),
+				LifecycleHookFunc(func(ctx context.Context) error {
+					return nil
+				}),
+				LifecycleHookFunc(func(ctx context.Context) error {
+					return errSecond
+				}),
+			)
+
+			err := hook.Run(context.Background())
+			if !assert.ErrorIs(t, err, errFirst) {
+				return
+			}
+			if !assert.ErrorIs(t, err, errSecond) {
+				return
+			}
+		})
+
+		t.Run("if a lifecycle hook panics", func(t *testing.T) {
+			hook := ComposeLifecycleHooksConcurrently(
+				LifecycleHookFunc(func(ctx context.Context) error {
+					panic("hello world")
+				}),
+			)
+
+			err := hook.Run(context.Background())
+
+			var perr bedrock.PanicError
+			if !assert.ErrorAs(t, err, &perr) {
+				return
+			}
+		})
+	})
+
+	t.Run("will run all hooks concurrently", func(t *testing.T) {
+		t.Run("if they block on each other", func(t *testing.T) {
+			n := 3
+			var wg sync.WaitGroup
+			wg.Add(n)
+
+			hooks := make([]LifecycleHook, n)
+			for i := range hooks {
+				hooks[i] = LifecycleHookFunc(func(ctx context.Context) error {
+					wg.Done()
+					wg.Wait()
+					return nil
+				})
+			}
+
+			err := ComposeLifecycleHooksConcurrently(hooks...).Run(context.Background())
+			if !assert.Nil(t, err) {
+				return
+			}
+		})
+	})
+}
+
+func TestComposeLifecycleHooksConcurrentlyN(t *testing.T) {
+	t.Run("will not run more hooks at once than the limit", func(t *testing.T) {
+		t.Run("if there are more hooks than the limit", func(t *testing.T) {
+			var (
+				mu       sync.Mutex
+				inFlight int
+				peak     int
+			)
+			hook := LifecycleHookFunc(func(ctx context.Context) error {
+				mu.Lock()
+				inFlight++
+				peak = max(peak, inFlight)
+				mu.Unlock()
+
+				time.Sleep(5 * time.Millisecond)
+
+				mu.Lock()
+				inFlight--
+				mu.Unlock()
+				return nil
+			})
+
+			hooks := make([]LifecycleHook, 10)
+			for i := range hooks {
+				hooks[i] = hook
+			}
+
+			err := ComposeLifecycleHooksConcurrentlyN(3, hooks...).Run(context.Background())
+			if !assert.Nil(t, err) {
+				return
+			}
+			if !assert.Equal(t, 3, peak) {
+				return
+			}
+		})
+	})
+
+	t.Run("will return an error", func(t *testing.T) {
+		t.Run("if a lifecycle hook fails", func(t *testing.T) {
+			errHookFailed := errors.New("failed to run hook")
+
+			hook := ComposeLifecycleHooksConcurrentlyN(1,
+				LifecycleHookFunc(func(ctx context.Context) error {
+					return nil
+				}),
+				LifecycleHookFunc(func(ctx context.Context) error {
+					return errHookFailed
+				}),
+			)
+
+			err := hook.Run(context.Background())
+			if !assert.ErrorIs(t, err, errHookFailed) {
+				return
+			}
+		})
+	})
+}
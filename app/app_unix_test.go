@@ -0,0 +1,66 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+//go:build unix
+
+package app
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithSignalNotifications_unix(t *testing.T) {
+	t.Run("will only cancel the context", func(t *testing.T) {
+		t.Run("if one of the configured signals is received", func(t *testing.T) {
+			// Catch SIGUSR2 so its default action doesn't terminate the test process.
+			ignored := make(chan os.Signal, 1)
+			signal.Notify(ignored, syscall.SIGUSR2)
+			defer signal.Stop(ignored)
+
+			notified := make(chan struct{})
+			cancelled := make(chan struct{})
+			app := WithSignalNotifications(runFunc(func(ctx context.Context) error {
+				close(notified)
+				<-ctx.Done()
+				close(cancelled)
+				return ctx.Err()
+			}), syscall.SIGUSR1)
+
+			errCh := make(chan error, 1)
+			go func() {
+				errCh <- app.Run(context.Background())
+			}()
+			<-notified
+
+			err := syscall.Kill(os.Getpid(), syscall.SIGUSR2)
+			if !assert.Nil(t, err) {
+				return
+			}
+			<-ignored
+
+			select {
+			case <-cancelled:
+				assert.Fail(t, "context should not be cancelled by an unconfigured signal")
+				return
+			case <-time.After(50 * time.Millisecond):
+			}
+
+			err = syscall.Kill(os.Getpid(), syscall.SIGUSR1)
+			if !assert.Nil(t, err) {
+				return
+			}
+			if !assert.ErrorIs(t, <-errCh, context.Canceled) {
+				return
+			}
+		})
+	})
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package app
+
+import (
+	"context"
+	"sync"
+
+	"github.com/z5labs/bedrock"
+)
+
+// ComposeApps combines multiple [bedrock.App]s into a single [bedrock.App].
+// Each app is ran concurrently and the first one to return an error, or panic,
+// cancels the [context.Context] passed to the others. Run only returns once
+// all apps have returned and the returned error is the first error to occur.
+//
+// Panics are recovered per app and returned as a [bedrock.PanicError].
+func ComposeApps(apps ...bedrock.App) bedrock.App {
+	return runFunc(func(ctx context.Context) error {
+		switch len(apps) {
+		case 0:
+			return nil
+		case 1:
+			return Recover(apps[0]).Run(ctx)
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		var (
+			wg       sync.WaitGroup
+			errOnce  sync.Once
+			firstErr error
+		)
+		for _, app := range apps {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				err := Recover(app).Run(ctx)
+				if err == nil {
+					return
+				}
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}()
+		}
+
+		wg.Wait()
+		return firstErr
+	})
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/z5labs/bedrock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComposeApps(t *testing.T) {
+	t.Run("will return an error", func(t *testing.T) {
+		t.Run("if a single app is given and it fails", func(t *testing.T) {
+			appErr := errors.New("failed to run")
+			app := ComposeApps(runFunc(func(ctx context.Context) error {
+				return appErr
+			}))
+
+			err := app.Run(context.Background())
+			if !assert.ErrorIs(t, err, appErr) {
+				return
+			}
+		})
+
+		t.Run("if one of the apps fails", func(t *testing.T) {
+			appErr := errors.New("failed to run")
+			app := ComposeApps(
+				runFunc(func(ctx context.Context) error {
+					<-ctx.Done()
+					return nil
+				}),
+				runFunc(func(ctx context.Context) error {
+					return appErr
+				}),
+			)
+
+			err := app.Run(context.Background())
+			if !assert.ErrorIs(t, err, appErr) {
+				return
+			}
+		})
+
+		t.Run("if one of the apps panics", func(t *testing.T) {
+			app := ComposeApps(
+				runFunc(func(ctx context.Context) error {
+					<-ctx.Done()
+					return nil
+				}),
+				runFunc(func(ctx context.Context) error {
+					panic("hello world")
+				}),
+			)
+
+			err := app.Run(context.Background())
+
+			var perr bedrock.PanicError
+			if !assert.ErrorAs(t, err, &perr) {
+				return
+			}
+			if !assert.Equal(t, "hello world", perr.Value) {
+				return
+			}
+		})
+
+		t.Run("if a single app is given and it panics", func(t *testing.T) {
+			app := ComposeApps(runFunc(func(ctx context.Context) error {
+				panic("hello world")
+			}))
+
+			err := app.Run(context.Background())
+
+			var perr bedrock.PanicError
+			if !assert.ErrorAs(t, err, &perr) {
+				return
+			}
+		})
+	})
+
+	t.Run("will not return an error", func(t *testing.T) {
+		t.Run("if no apps are given", func(t *testing.T) {
+			err := ComposeApps().Run(context.Background())
+			if !assert.Nil(t, err) {
+				return
+			}
+		})
+
+		t.Run("if all apps succeed", func(t *testing.T) {
+			ran := make(chan struct{}, 3)
+			app := runFunc(func(ctx context.Context) error {
+				ran <- struct{}{}
+				return nil
+			})
+
+			err := ComposeApps(app, app, app).Run(context.Background())
+			if !assert.Nil(t, err) {
+				return
+			}
+			if !assert.Len(t, ran, 3) {
+				return
+			}
+		})
+	})
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package app
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/z5labs/bedrock"
+)
+
+// ErrRunDeadlineExceeded is returned by an app wrapped with [WithMaxRunDuration]
+// if it was still running once the max run duration elapsed.
+var ErrRunDeadlineExceeded = errors.New("app exceeded max run duration")
+
+// WithMaxRunDuration wraps a given [bedrock.App] in an implementation which
+// cancels the [context.Context] passed to app.Run once the given duration has
+// elapsed. This is useful for bounding batch style apps, e.g. cron jobs.
+//
+// If the duration elapses, [ErrRunDeadlineExceeded] is returned unless app.Run
+// fails with an error unrelated to the deadline. Since only app.Run is bounded,
+// a [Lifecycle.PostRun] hook applied via [WithLifecycleHooks] will still be
+// executed. A zero duration means no limit.
+func WithMaxRunDuration(app bedrock.App, d time.Duration) bedrock.App {
+	if d <= 0 {
+		return app
+	}
+
+	return runFunc(func(ctx context.Context) error {
+		ctx, cancel := context.WithTimeoutCause(ctx, d, ErrRunDeadlineExceeded)
+		defer cancel()
+
+		err := app.Run(ctx)
+		if context.Cause(ctx) != ErrRunDeadlineExceeded {
+			return err
+		}
+		if err == nil || errors.Is(err, context.DeadlineExceeded) {
+			return ErrRunDeadlineExceeded
+		}
+		return err
+	})
+}
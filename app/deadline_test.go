@@ -0,0 +1,98 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithMaxRunDuration(t *testing.T) {
+	t.Run("will return an error", func(t *testing.T) {
+		t.Run("if the app is still running after the max run duration", func(t *testing.T) {
+			app := WithMaxRunDuration(runFunc(func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}), 10*time.Millisecond)
+
+			err := app.Run(context.Background())
+			if !assert.ErrorIs(t, err, ErrRunDeadlineExceeded) {
+				return
+			}
+		})
+
+		t.Run("if the app returns nil after the max run duration", func(t *testing.T) {
+			app := WithMaxRunDuration(runFunc(func(ctx context.Context) error {
+				<-ctx.Done()
+				return nil
+			}), 10*time.Millisecond)
+
+			err := app.Run(context.Background())
+			if !assert.ErrorIs(t, err, ErrRunDeadlineExceeded) {
+				return
+			}
+		})
+
+		t.Run("if the app fails before the max run duration", func(t *testing.T) {
+			appErr := errors.New("failed to run")
+			app := WithMaxRunDuration(runFunc(func(ctx context.Context) error {
+				return appErr
+			}), time.Minute)
+
+			err := app.Run(context.Background())
+			if !assert.ErrorIs(t, err, appErr) {
+				return
+			}
+		})
+
+		t.Run("if the parent context is cancelled", func(t *testing.T) {
+			app := WithMaxRunDuration(runFunc(func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}), time.Minute)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			err := app.Run(ctx)
+			if !assert.ErrorIs(t, err, context.Canceled) {
+				return
+			}
+		})
+	})
+
+	t.Run("will not return an error", func(t *testing.T) {
+		t.Run("if the app returns before the max run duration", func(t *testing.T) {
+			app := WithMaxRunDuration(runFunc(func(ctx context.Context) error {
+				return nil
+			}), time.Minute)
+
+			err := app.Run(context.Background())
+			if !assert.Nil(t, err) {
+				return
+			}
+		})
+
+		t.Run("if the max run duration is zero", func(t *testing.T) {
+			app := WithMaxRunDuration(runFunc(func(ctx context.Context) error {
+				_, ok := ctx.Deadline()
+				if ok {
+					return errors.New("unexpected deadline")
+				}
+				return nil
+			}), 0)
+
+			err := app.Run(context.Background())
+			if !assert.Nil(t, err) {
+				return
+			}
+		})
+	})
+}
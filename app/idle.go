@@ -0,0 +1,83 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package app
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/z5labs/bedrock"
+)
+
+// IdleSignal is used to report activity to an app wrapped by [WithIdleShutdown].
+type IdleSignal struct {
+	touched chan struct{}
+}
+
+// NewIdleSignal initializes an [IdleSignal].
+func NewIdleSignal() *IdleSignal {
+	return &IdleSignal{
+		touched: make(chan struct{}, 1),
+	}
+}
+
+// Touch reports activity, resetting the idle timer. It never blocks
+// and is safe to call concurrently.
+func (s *IdleSignal) Touch() {
+	select {
+	case s.touched <- struct{}{}:
+	default:
+	}
+}
+
+// WithIdleShutdown wraps a given [bedrock.App] in an implementation that cancels
+// the [context.Context] passed to app.Run if [IdleSignal.Touch] is not called
+// for the given idle duration. This is useful for scale-to-zero workloads which
+// should exit cleanly once there is no more work to do.
+//
+// If the app then returns [context.Canceled], Run returns nil since shutting down
+// due to inactivity is not considered a failure. Activity reported after the idle
+// duration has elapsed does not abort the shutdown.
+//
+// A zero idle duration or nil [IdleSignal] disables idle shutdown and the
+// app is returned unchanged.
+func WithIdleShutdown(app bedrock.App, idle time.Duration, sig *IdleSignal) bedrock.App {
+	if idle <= 0 || sig == nil {
+		return app
+	}
+
+	return runFunc(func(ctx context.Context) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		var idled atomic.Bool
+		go func() {
+			timer := time.NewTimer(idle)
+			defer timer.Stop()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-sig.touched:
+					timer.Reset(idle)
+				case <-timer.C:
+					idled.Store(true)
+					cancel()
+					return
+				}
+			}
+		}()
+
+		err := app.Run(ctx)
+		if idled.Load() && errors.Is(err, context.Canceled) {
+			return nil
+		}
+		return err
+	})
+}
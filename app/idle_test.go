@@ -0,0 +1,121 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithIdleShutdown(t *testing.T) {
+	t.Run("will not return an error", func(t *testing.T) {
+		t.Run("if the app is cancelled due to inactivity", func(t *testing.T) {
+			sig := NewIdleSignal()
+			app := WithIdleShutdown(runFunc(func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}), 10*time.Millisecond, sig)
+
+			err := app.Run(context.Background())
+			if !assert.Nil(t, err) {
+				return
+			}
+		})
+	})
+
+	t.Run("will not shutdown", func(t *testing.T) {
+		t.Run("if the idle duration is zero", func(t *testing.T) {
+			app := WithIdleShutdown(runFunc(func(ctx context.Context) error {
+				select {
+				case <-ctx.Done():
+					return errors.New("unexpected shutdown")
+				case <-time.After(20 * time.Millisecond):
+					return nil
+				}
+			}), 0, NewIdleSignal())
+
+			err := app.Run(context.Background())
+			if !assert.Nil(t, err) {
+				return
+			}
+		})
+
+		t.Run("if the IdleSignal is nil", func(t *testing.T) {
+			app := WithIdleShutdown(runFunc(func(ctx context.Context) error {
+				select {
+				case <-ctx.Done():
+					return errors.New("unexpected shutdown")
+				case <-time.After(20 * time.Millisecond):
+					return nil
+				}
+			}), time.Millisecond, nil)
+
+			err := app.Run(context.Background())
+			if !assert.Nil(t, err) {
+				return
+			}
+		})
+	})
+
+	t.Run("will return an error", func(t *testing.T) {
+		t.Run("if the app fails for a reason other than inactivity", func(t *testing.T) {
+			appErr := errors.New("failed to run")
+			sig := NewIdleSignal()
+			app := WithIdleShutdown(runFunc(func(ctx context.Context) error {
+				return appErr
+			}), time.Minute, sig)
+
+			err := app.Run(context.Background())
+			if !assert.ErrorIs(t, err, appErr) {
+				return
+			}
+		})
+
+		t.Run("if the parent context is cancelled", func(t *testing.T) {
+			sig := NewIdleSignal()
+			app := WithIdleShutdown(runFunc(func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}), time.Minute, sig)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			err := app.Run(ctx)
+			if !assert.ErrorIs(t, err, context.Canceled) {
+				return
+			}
+		})
+	})
+
+	t.Run("will delay shutdown", func(t *testing.T) {
+		t.Run("if activity is reported before the idle duration elapses", func(t *testing.T) {
+			idle := 50 * time.Millisecond
+			sig := NewIdleSignal()
+			app := WithIdleShutdown(runFunc(func(ctx context.Context) error {
+				for range 5 {
+					time.Sleep(idle / 5)
+					sig.Touch()
+				}
+				<-ctx.Done()
+				return ctx.Err()
+			}), idle, sig)
+
+			start := time.Now()
+			err := app.Run(context.Background())
+			if !assert.Nil(t, err) {
+				return
+			}
+			if !assert.GreaterOrEqual(t, time.Since(start), 2*idle) {
+				return
+			}
+		})
+	})
+}
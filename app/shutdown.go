@@ -0,0 +1,59 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package app
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/z5labs/bedrock"
+	"github.com/z5labs/bedrock/internal/async"
+)
+
+// ErrShutdownTimeout is returned by an app wrapped with [WithShutdownTimeout]
+// if it did not return within the shutdown timeout.
+var ErrShutdownTimeout = errors.New("app did not shutdown before timeout")
+
+// WithShutdownTimeout wraps a given [bedrock.App] in an implementation which,
+// once the [context.Context] passed to app.Run is cancelled, waits at most
+// the given timeout for app.Run to return. If the timeout expires first then
+// [ErrShutdownTimeout] is returned and app.Run is left to finish in the background.
+//
+// This bounds how long a misbehaving app can delay anything wrapping it, e.g.
+// a [Lifecycle.PostRun] hook applied via [WithLifecycleHooks] will still be executed.
+// To be able to stop waiting on it, app.Run is executed in its own goroutine and a
+// panic it raises is returned as a [bedrock.PanicError].
+//
+// A zero timeout means wait forever and the app is returned unchanged, so app.Run
+// is then executed on the calling goroutine and its panics are not recovered.
+func WithShutdownTimeout(app bedrock.App, timeout time.Duration) bedrock.App {
+	if timeout <= 0 {
+		return app
+	}
+
+	return runFunc(func(ctx context.Context) error {
+		errCh := async.Go(func() error {
+			return app.Run(ctx)
+		})
+
+		select {
+		case err := <-errCh:
+			return err
+		case <-ctx.Done():
+		}
+
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+
+		select {
+		case err := <-errCh:
+			return err
+		case <-timer.C:
+			return ErrShutdownTimeout
+		}
+	})
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/z5labs/bedrock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithShutdownTimeout(t *testing.T) {
+	t.Run("will return an error", func(t *testing.T) {
+		t.Run("if the app does not return before the timeout", func(t *testing.T) {
+			release := make(chan struct{})
+			defer close(release)
+
+			app := WithShutdownTimeout(runFunc(func(ctx context.Context) error {
+				<-release
+				return nil
+			}), 10*time.Millisecond)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			err := app.Run(ctx)
+			if !assert.ErrorIs(t, err, ErrShutdownTimeout) {
+				return
+			}
+		})
+
+		t.Run("if the app fails before the context is cancelled", func(t *testing.T) {
+			appErr := errors.New("failed to run")
+			app := WithShutdownTimeout(runFunc(func(ctx context.Context) error {
+				return appErr
+			}), time.Minute)
+
+			err := app.Run(context.Background())
+			if !assert.ErrorIs(t, err, appErr) {
+				return
+			}
+		})
+
+		t.Run("if the app panics", func(t *testing.T) {
+			app := WithShutdownTimeout(runFunc(func(ctx context.Context) error {
+				panic("hello world")
+			}), time.Minute)
+
+			err := app.Run(context.Background())
+
+			var perr bedrock.PanicError
+			if !assert.ErrorAs(t, err, &perr) {
+				return
+			}
+		})
+	})
+
+	t.Run("will still run PostRun hooks", func(t *testing.T) {
+		t.Run("if the timeout expires", func(t *testing.T) {
+			release := make(chan struct{})
+			defer close(release)
+
+			postRan := false
+			app := WithLifecycleHooks(
+				WithShutdownTimeout(runFunc(func(ctx context.Context) error {
+					<-release
+					return nil
+				}), 10*time.Millisecond),
+				Lifecycle{
+					PostRun: LifecycleHookFunc(func(ctx context.Context) error {
+						postRan = true
+						return nil
+					}),
+				},
+			)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			err := app.Run(ctx)
+			if !assert.ErrorIs(t, err, ErrShutdownTimeout) {
+				return
+			}
+			if !assert.True(t, postRan) {
+				return
+			}
+		})
+	})
+
+	t.Run("will return the app result", func(t *testing.T) {
+		t.Run("if the app returns within the timeout after cancellation", func(t *testing.T) {
+			app := WithShutdownTimeout(runFunc(func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}), time.Minute)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			err := app.Run(ctx)
+			if !assert.ErrorIs(t, err, context.Canceled) {
+				return
+			}
+		})
+	})
+}
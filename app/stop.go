@@ -0,0 +1,101 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package app
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/z5labs/bedrock"
+)
+
+// ErrAlreadyRan is returned by [StoppableApp.Run] if it is called more than once,
+// regardless if the first call is still running or has already returned.
+var ErrAlreadyRan = errors.New("app has already been ran")
+
+// StoppableApp is a [bedrock.App] which can be stopped programmatically,
+// e.g. from an admin endpoint or a test, instead of only via its [context.Context].
+type StoppableApp struct {
+	app bedrock.App
+
+	mu      sync.Mutex
+	started bool
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// WithStop wraps a given [bedrock.App] in a [StoppableApp].
+func WithStop(app bedrock.App) *StoppableApp {
+	return &StoppableApp{
+		app:  app,
+		done: make(chan struct{}),
+	}
+}
+
+// Run implements the [bedrock.App] interface. The [context.Context] passed to
+// the underlying [bedrock.App] is cancelled when either the given [context.Context]
+// is cancelled or [StoppableApp.Stop] is called. Run may only be called once.
+func (s *StoppableApp) Run(ctx context.Context) error {
+	s.mu.Lock()
+	if s.started {
+		s.mu.Unlock()
+		return ErrAlreadyRan
+	}
+	s.started = true
+
+	ctx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+	s.mu.Unlock()
+
+	defer close(s.done)
+	defer cancel()
+
+	return s.app.Run(ctx)
+}
+
+// Stop cancels the [context.Context] of the running [bedrock.App] and blocks
+// until its Run method returns or the given [context.Context] is cancelled,
+// in which case the [context.Context] error is returned.
+//
+// Since Stop waits for Run to return, it must not be called synchronously from
+// within the underlying [bedrock.App], otherwise it will block until the given
+// [context.Context] is cancelled. Use [StoppableApp.RequestStop] there instead.
+//
+// Calling Stop before Run or after Run has returned is a no-op and returns nil.
+func (s *StoppableApp) Stop(ctx context.Context) error {
+	if !s.RequestStop() {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-s.done:
+		return nil
+	}
+}
+
+// RequestStop cancels the [context.Context] of the running [bedrock.App] without
+// waiting for its Run method to return, which makes it safe to call from within
+// the underlying [bedrock.App]. It reports whether Run had been called, calling it
+// before Run is a no-op.
+func (s *StoppableApp) RequestStop() bool {
+	s.mu.Lock()
+	cancel := s.cancel
+	s.mu.Unlock()
+	if cancel == nil {
+		return false
+	}
+
+	cancel()
+	return true
+}
+
+// Done returns a channel which is closed once Run has returned.
+func (s *StoppableApp) Done() <-chan struct{} {
+	return s.done
+}
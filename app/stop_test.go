@@ -0,0 +1,164 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStoppableApp_Run(t *testing.T) {
+	t.Run("will return an error", func(t *testing.T) {
+		t.Run("if it has already been called", func(t *testing.T) {
+			app := WithStop(runFunc(func(ctx context.Context) error {
+				return nil
+			}))
+
+			err := app.Run(context.Background())
+			if !assert.Nil(t, err) {
+				return
+			}
+
+			err = app.Run(context.Background())
+			if !assert.ErrorIs(t, err, ErrAlreadyRan) {
+				return
+			}
+		})
+	})
+}
+
+func TestStoppableApp_Stop(t *testing.T) {
+	t.Run("will cancel the app context", func(t *testing.T) {
+		t.Run("if called from another goroutine", func(t *testing.T) {
+			started := make(chan struct{})
+			app := WithStop(runFunc(func(ctx context.Context) error {
+				close(started)
+				<-ctx.Done()
+				return ctx.Err()
+			}))
+
+			errCh := make(chan error, 1)
+			go func() {
+				errCh <- app.Run(context.Background())
+			}()
+
+			<-started
+			err := app.Stop(context.Background())
+			if !assert.Nil(t, err) {
+				return
+			}
+
+			<-app.Done()
+			if !assert.ErrorIs(t, <-errCh, context.Canceled) {
+				return
+			}
+		})
+
+		t.Run("if called from within the app", func(t *testing.T) {
+			var app *StoppableApp
+			app = WithStop(runFunc(func(ctx context.Context) error {
+				go app.Stop(context.Background())
+
+				<-ctx.Done()
+				return nil
+			}))
+
+			err := app.Run(context.Background())
+			if !assert.Nil(t, err) {
+				return
+			}
+		})
+	})
+
+	t.Run("will return an error", func(t *testing.T) {
+		t.Run("if the app does not stop before the given context is cancelled", func(t *testing.T) {
+			started := make(chan struct{})
+			release := make(chan struct{})
+			app := WithStop(runFunc(func(ctx context.Context) error {
+				close(started)
+				<-release
+				return nil
+			}))
+			defer close(release)
+
+			go app.Run(context.Background())
+			<-started
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			err := app.Stop(ctx)
+			if !assert.ErrorIs(t, err, context.Canceled) {
+				return
+			}
+		})
+	})
+
+	t.Run("will not return an error", func(t *testing.T) {
+		t.Run("if called before Run", func(t *testing.T) {
+			app := WithStop(runFunc(func(ctx context.Context) error {
+				return nil
+			}))
+
+			err := app.Stop(context.Background())
+			if !assert.Nil(t, err) {
+				return
+			}
+		})
+
+		t.Run("if called after Run has returned", func(t *testing.T) {
+			app := WithStop(runFunc(func(ctx context.Context) error {
+				return nil
+			}))
+
+			err := app.Run(context.Background())
+			if !assert.Nil(t, err) {
+				return
+			}
+
+			err = app.Stop(context.Background())
+			if !assert.Nil(t, err) {
+				return
+			}
+		})
+	})
+}
+
+func TestStoppableApp_RequestStop(t *testing.T) {
+	t.Run("will cancel the app context", func(t *testing.T) {
+		t.Run("if called synchronously from within the app", func(t *testing.T) {
+			var app *StoppableApp
+			app = WithStop(runFunc(func(ctx context.Context) error {
+				if !app.RequestStop() {
+					return errors.New("expected stop to be requested")
+				}
+
+				<-ctx.Done()
+				return nil
+			}))
+
+			err := app.Run(context.Background())
+			if !assert.Nil(t, err) {
+				return
+			}
+		})
+	})
+
+	t.Run("will be a no-op", func(t *testing.T) {
+		t.Run("if called before Run", func(t *testing.T) {
+			app := WithStop(runFunc(func(ctx context.Context) error {
+				return nil
+			}))
+
+			if !assert.False(t, app.RequestStop()) {
+				return
+			}
+		})
+	})
+}
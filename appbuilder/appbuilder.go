@@ -9,12 +9,16 @@ import (
 	"context"
 
 	"github.com/z5labs/bedrock"
+	"github.com/z5labs/bedrock/app"
 )
 
-// Recover will wrap the give [bedrock.AppBuilder] with panic recovery.
-// If the recovered panic value implements [error] then it will
-// be directly returned. If it does not implement [error] then a
-// [PanicError] will be returned instead.
+// Recover will wrap the given [bedrock.AppBuilder] with panic recovery.
+// The recovered panic value is always returned as a [bedrock.PanicError],
+// even if it implements [error], so callers can distinguish a panic from
+// a returned error. If the value does implement [error] then it can still
+// be matched with [errors.Is] and [errors.As] via [bedrock.PanicError.Unwrap].
+// Calls to [runtime.Goexit] are not recovered and will still terminate the
+// calling goroutine.
 func Recover[T any](builder bedrock.AppBuilder[T]) bedrock.AppBuilder[T] {
 	return bedrock.AppBuilderFunc[T](func(ctx context.Context, cfg T) (_ bedrock.App, err error) {
 		defer bedrock.Recover(&err)
@@ -22,3 +26,52 @@ func Recover[T any](builder bedrock.AppBuilder[T]) bedrock.AppBuilder[T] {
 		return builder.Build(ctx, cfg)
 	})
 }
+
+// Lifecycle is used for running functionality around the building
+// of a [bedrock.App]. It is applied with [WithLifecycleHooks] and
+// its hooks are executed in the following order:
+//
+//  1. PreBuild
+//  2. [bedrock.AppBuilder.Build], only if PreBuild succeeded
+//  3. PostBuild, only if Build succeeded
+//
+// Both hooks are optional and multiple hooks can be combined into a
+// single phase with [app.ComposeLifecycleHooks].
+type Lifecycle struct {
+	// PreBuild is executed before the underlying [bedrock.AppBuilder].
+	// If it returns an error then the [bedrock.App] is not built.
+	PreBuild app.LifecycleHook
+
+	// PostBuild is executed after the underlying [bedrock.AppBuilder]
+	// successfully builds the [bedrock.App] but before it is ran. If it
+	// returns an error then the built [bedrock.App] is discarded without
+	// ever being ran, so any resources it acquired during Build, which it
+	// would otherwise release when ran, are not released.
+	PostBuild app.LifecycleHook
+}
+
+// WithLifecycleHooks wraps a given [bedrock.AppBuilder] in an implementation
+// that runs [app.LifecycleHook]s around the execution of builder.Build.
+func WithLifecycleHooks[T any](builder bedrock.AppBuilder[T], lifecycle Lifecycle) bedrock.AppBuilder[T] {
+	return bedrock.AppBuilderFunc[T](func(ctx context.Context, cfg T) (bedrock.App, error) {
+		if lifecycle.PreBuild != nil {
+			err := lifecycle.PreBuild.Run(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		a, err := builder.Build(ctx, cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		if lifecycle.PostBuild != nil {
+			err = lifecycle.PostBuild.Run(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return a, nil
+	})
+}
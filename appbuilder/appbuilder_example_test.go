@@ -18,7 +18,6 @@ func ExampleRecover() {
 
 	builder := bedrock.AppBuilderFunc[MyConfig](func(ctx context.Context, cfg MyConfig) (bedrock.App, error) {
 		panic("hello world")
-		return nil, nil
 	})
 
 	_, err := Recover(builder).Build(context.Background(), MyConfig{})
@@ -38,7 +37,6 @@ func ExampleRecover_errorValue() {
 
 	builder := bedrock.AppBuilderFunc[MyConfig](func(ctx context.Context, cfg MyConfig) (bedrock.App, error) {
 		panic(errors.New("hello world"))
-		return nil, nil
 	})
 
 	_, err := Recover(builder).Build(context.Background(), MyConfig{})
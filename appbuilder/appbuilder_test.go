@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/z5labs/bedrock"
+	"github.com/z5labs/bedrock/app"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -33,19 +34,22 @@ func TestRecover(t *testing.T) {
 			buildErr := errors.New("failed to build")
 			builder := Recover(bedrock.AppBuilderFunc[struct{}](func(ctx context.Context, cfg struct{}) (bedrock.App, error) {
 				panic(buildErr)
-				return nil, nil
 			}))
 
 			_, err := builder.Build(context.Background(), struct{}{})
 			if !assert.ErrorIs(t, err, buildErr) {
 				return
 			}
+
+			var perr bedrock.PanicError
+			if !assert.ErrorAs(t, err, &perr) {
+				return
+			}
 		})
 
 		t.Run("if the underlying App panics with a non-error value", func(t *testing.T) {
 			builder := Recover(bedrock.AppBuilderFunc[struct{}](func(ctx context.Context, cfg struct{}) (bedrock.App, error) {
 				panic("hello world")
-				return nil, nil
 			}))
 
 			_, err := builder.Build(context.Background(), struct{}{})
@@ -63,3 +67,104 @@ func TestRecover(t *testing.T) {
 		})
 	})
 }
+
+func TestWithLifecycleHooks(t *testing.T) {
+	t.Run("will return an error", func(t *testing.T) {
+		t.Run("if the PreBuild hook fails", func(t *testing.T) {
+			hookErr := errors.New("failed to run hook")
+			built := false
+			builder := WithLifecycleHooks(bedrock.AppBuilderFunc[struct{}](func(ctx context.Context, cfg struct{}) (bedrock.App, error) {
+				built = true
+				return appFunc(func(ctx context.Context) error {
+					return nil
+				}), nil
+			}), Lifecycle{
+				PreBuild: app.LifecycleHookFunc(func(ctx context.Context) error {
+					return hookErr
+				}),
+			})
+
+			_, err := builder.Build(context.Background(), struct{}{})
+			if !assert.ErrorIs(t, err, hookErr) {
+				return
+			}
+			if !assert.False(t, built) {
+				return
+			}
+		})
+
+		t.Run("if the underlying AppBuilder fails", func(t *testing.T) {
+			buildErr := errors.New("failed to build")
+			postBuildCalled := false
+			builder := WithLifecycleHooks(bedrock.AppBuilderFunc[struct{}](func(ctx context.Context, cfg struct{}) (bedrock.App, error) {
+				return nil, buildErr
+			}), Lifecycle{
+				PostBuild: app.LifecycleHookFunc(func(ctx context.Context) error {
+					postBuildCalled = true
+					return nil
+				}),
+			})
+
+			_, err := builder.Build(context.Background(), struct{}{})
+			if !assert.ErrorIs(t, err, buildErr) {
+				return
+			}
+			if !assert.False(t, postBuildCalled) {
+				return
+			}
+		})
+
+		t.Run("if the PostBuild hook fails", func(t *testing.T) {
+			hookErr := errors.New("failed to run hook")
+			builder := WithLifecycleHooks(bedrock.AppBuilderFunc[struct{}](func(ctx context.Context, cfg struct{}) (bedrock.App, error) {
+				return appFunc(func(ctx context.Context) error {
+					return nil
+				}), nil
+			}), Lifecycle{
+				PostBuild: app.LifecycleHookFunc(func(ctx context.Context) error {
+					return hookErr
+				}),
+			})
+
+			a, err := builder.Build(context.Background(), struct{}{})
+			if !assert.ErrorIs(t, err, hookErr) {
+				return
+			}
+			if !assert.Nil(t, a) {
+				return
+			}
+		})
+	})
+
+	t.Run("will run hooks in order", func(t *testing.T) {
+		t.Run("if all hooks and the underlying AppBuilder succeed", func(t *testing.T) {
+			var calls []string
+			builder := WithLifecycleHooks(bedrock.AppBuilderFunc[struct{}](func(ctx context.Context, cfg struct{}) (bedrock.App, error) {
+				calls = append(calls, "build")
+				return appFunc(func(ctx context.Context) error {
+					return nil
+				}), nil
+			}), Lifecycle{
+				PreBuild: app.LifecycleHookFunc(func(ctx context.Context) error {
+					calls = append(calls, "pre")
+					return nil
+				}),
+				PostBuild: app.LifecycleHookFunc(func(ctx context.Context) error {
+					calls = append(calls, "post")
+					return nil
+				}),
+			})
+
+			a, err := builder.Build(context.Background(), struct{}{})
+			if !assert.Nil(t, err) {
+				return
+			}
+			if !assert.NotNil(t, a) {
+				return
+			}
+			if !assert.Equal(t, []string{"pre", "build", "post"}, calls) {
+				return
+			}
+		})
+	})
+}
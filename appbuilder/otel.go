@@ -7,6 +7,8 @@ package appbuilder
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/z5labs/bedrock"
 	"github.com/z5labs/bedrock/app"
@@ -19,10 +21,42 @@ type OTelInitializer interface {
 	InitializeOTel(context.Context) error
 }
 
+// DefaultOTelShutdownTimeout is the default maximum amount of time the OTel
+// SDK is given to shutdown, and flush any buffered telemetry, by [OTel].
+const DefaultOTelShutdownTimeout = 30 * time.Second
+
+type otelOptions struct {
+	shutdownTimeout time.Duration
+}
+
+// OTelOption represents options for configuring the [OTel] middleware.
+type OTelOption func(*otelOptions)
+
+// OTelShutdownTimeout sets the maximum amount of time the OTel SDK is given
+// to shutdown. A zero timeout means the shutdown is never cancelled.
+func OTelShutdownTimeout(d time.Duration) OTelOption {
+	return func(oo *otelOptions) {
+		oo.shutdownTimeout = d
+	}
+}
+
 // OTel is a [bedrock.AppBuilder] middleware which initializes the OTel SDK.
 // It also ensures that the OTel SDK is properly shutdown when the built [bedrock.App]
-// stops running.
-func OTel[T OTelInitializer](builder bedrock.AppBuilder[T]) bedrock.AppBuilder[T] {
+// stops running. Shutdown is not aborted if the [bedrock.App] was cancelled, e.g. due
+// to a signal, but is instead bounded by [DefaultOTelShutdownTimeout] unless set
+// with [OTelShutdownTimeout].
+//
+// If the given [bedrock.AppBuilder] fails, the OTel SDK is shutdown immediately, since
+// there will be no [bedrock.App] to do so, and any shutdown error is joined with the
+// build error.
+func OTel[T OTelInitializer](builder bedrock.AppBuilder[T], opts ...OTelOption) bedrock.AppBuilder[T] {
+	oo := otelOptions{
+		shutdownTimeout: DefaultOTelShutdownTimeout,
+	}
+	for _, opt := range opts {
+		opt(&oo)
+	}
+
 	return bedrock.AppBuilderFunc[T](func(ctx context.Context, cfg T) (bedrock.App, error) {
 		err := cfg.InitializeOTel(ctx)
 		if err != nil {
@@ -31,20 +65,32 @@ func OTel[T OTelInitializer](builder bedrock.AppBuilder[T]) bedrock.AppBuilder[T
 
 		base, err := builder.Build(ctx, cfg)
 		if err != nil {
-			return nil, err
+			shutdownCtx := context.WithoutCancel(ctx)
+			if oo.shutdownTimeout > 0 {
+				var cancel context.CancelFunc
+				shutdownCtx, cancel = context.WithTimeout(shutdownCtx, oo.shutdownTimeout)
+				defer cancel()
+			}
+
+			return nil, errors.Join(err, shutdownOTel().Run(shutdownCtx))
 		}
 
 		base = app.WithLifecycleHooks(base, app.Lifecycle{
-			PostRun: app.ComposeLifecycleHooks(
-				tryShutdown(otel.GetTracerProvider()),
-				tryShutdown(otel.GetMeterProvider()),
-				tryShutdown(global.GetLoggerProvider()),
-			),
+			PostRun:        shutdownOTel(),
+			PostRunTimeout: oo.shutdownTimeout,
 		})
 		return base, nil
 	})
 }
 
+func shutdownOTel() app.LifecycleHook {
+	return app.ComposeLifecycleHooks(
+		tryShutdown(otel.GetTracerProvider()),
+		tryShutdown(otel.GetMeterProvider()),
+		tryShutdown(global.GetLoggerProvider()),
+	)
+}
+
 type shutdowner interface {
 	Shutdown(context.Context) error
 }
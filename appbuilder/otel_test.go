@@ -30,6 +30,9 @@ func (failToInitOTel) InitializeOTel(ctx context.Context) error {
 type noopInitOTel struct{}
 
 func (noopInitOTel) InitializeOTel(ctx context.Context) error {
+	otel.SetTracerProvider(tracenoop.NewTracerProvider())
+	otel.SetMeterProvider(metricnoop.NewMeterProvider())
+	global.SetLoggerProvider(lognoop.NewLoggerProvider())
 	return nil
 }
 
@@ -117,6 +120,29 @@ func (loggerProviderInitOTel) InitializeOTel(ctx context.Context) error {
 	return nil
 }
 
+type shutdownState struct {
+	err         error
+	hasDeadline bool
+}
+
+type deadlineInitOTel struct {
+	shutdownState chan shutdownState
+}
+
+func (cfg deadlineInitOTel) InitializeOTel(ctx context.Context) error {
+	otel.SetTracerProvider(newTracerProvider(func(ctx context.Context) error {
+		_, ok := ctx.Deadline()
+		cfg.shutdownState <- shutdownState{
+			err:         ctx.Err(),
+			hasDeadline: ok,
+		}
+		return nil
+	}))
+	otel.SetMeterProvider(metricnoop.NewMeterProvider())
+	global.SetLoggerProvider(lognoop.NewLoggerProvider())
+	return nil
+}
+
 func TestOTel(t *testing.T) {
 	t.Run("bedrock.AppBuilder will return an error", func(t *testing.T) {
 		t.Run("if InitializeOTel fails", func(t *testing.T) {
@@ -141,6 +167,21 @@ func TestOTel(t *testing.T) {
 				return
 			}
 		})
+
+		t.Run("if the given bedrock.AppBuilder fails and the OTel SDK fails to shutdown", func(t *testing.T) {
+			buildErr := errors.New("failed to build")
+			b := OTel(bedrock.AppBuilderFunc[tracerProviderInitOTel](func(ctx context.Context, cfg tracerProviderInitOTel) (bedrock.App, error) {
+				return nil, buildErr
+			}))
+
+			_, err := b.Build(context.Background(), tracerProviderInitOTel{})
+			if !assert.ErrorIs(t, err, buildErr) {
+				return
+			}
+			if !assert.ErrorIs(t, err, errTracerProviderFailedShutdown) {
+				return
+			}
+		})
 	})
 
 	t.Run("the built bedrock.App will return an error", func(t *testing.T) {
@@ -201,4 +242,71 @@ func TestOTel(t *testing.T) {
 			}
 		})
 	})
+
+	t.Run("the built bedrock.App will shutdown the OTel SDK with a deadline", func(t *testing.T) {
+		t.Run("if the context passed to it was cancelled", func(t *testing.T) {
+			cfg := deadlineInitOTel{
+				shutdownState: make(chan shutdownState, 1),
+			}
+			b := OTel(bedrock.AppBuilderFunc[deadlineInitOTel](func(ctx context.Context, cfg deadlineInitOTel) (bedrock.App, error) {
+				a := appFunc(func(ctx context.Context) error {
+					return nil
+				})
+				return a, nil
+			}))
+
+			app, err := b.Build(context.Background(), cfg)
+			if !assert.Nil(t, err) {
+				return
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			err = app.Run(ctx)
+			if !assert.Nil(t, err) {
+				return
+			}
+
+			state := <-cfg.shutdownState
+			if !assert.Nil(t, state.err) {
+				return
+			}
+			if !assert.True(t, state.hasDeadline) {
+				return
+			}
+		})
+	})
+
+	t.Run("the built bedrock.App will shutdown the OTel SDK without a deadline", func(t *testing.T) {
+		t.Run("if the shutdown timeout is zero", func(t *testing.T) {
+			cfg := deadlineInitOTel{
+				shutdownState: make(chan shutdownState, 1),
+			}
+			b := OTel(bedrock.AppBuilderFunc[deadlineInitOTel](func(ctx context.Context, cfg deadlineInitOTel) (bedrock.App, error) {
+				a := appFunc(func(ctx context.Context) error {
+					return nil
+				})
+				return a, nil
+			}), OTelShutdownTimeout(0))
+
+			app, err := b.Build(context.Background(), cfg)
+			if !assert.Nil(t, err) {
+				return
+			}
+
+			err = app.Run(context.Background())
+			if !assert.Nil(t, err) {
+				return
+			}
+
+			state := <-cfg.shutdownState
+			if !assert.Nil(t, state.err) {
+				return
+			}
+			if !assert.False(t, state.hasDeadline) {
+				return
+			}
+		})
+	})
 }
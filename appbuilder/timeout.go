@@ -0,0 +1,78 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package appbuilder
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/z5labs/bedrock"
+	"github.com/z5labs/bedrock/internal/async"
+)
+
+// ErrBuildTimeout is returned by a [bedrock.AppBuilder] wrapped with [WithTimeout]
+// if it did not finish building the [bedrock.App] before the timeout.
+var ErrBuildTimeout = errors.New("app builder did not finish before timeout")
+
+// WithTimeout wraps the given [bedrock.AppBuilder] such that the [context.Context]
+// passed to builder.Build is cancelled after the given timeout. If builder.Build
+// has not returned by then, [ErrBuildTimeout] is returned and builder.Build is
+// left to finish in the background. This prevents a builder which performs I/O,
+// e.g. dialing a database, from hanging the app forever during startup.
+//
+// To be able to abandon it, builder.Build is executed in its own goroutine. A panic
+// it raises before the timeout is returned as a [bedrock.PanicError], while one raised
+// after it has been abandoned is discarded. Likewise, a [bedrock.App] built after
+// builder.Build has been abandoned is discarded and never ran, so any resources it
+// acquired are never released.
+//
+// A zero timeout means wait forever and the builder is returned unchanged, so
+// builder.Build is then executed on the calling goroutine and its panics are
+// not recovered.
+func WithTimeout[T any](builder bedrock.AppBuilder[T], timeout time.Duration) bedrock.AppBuilder[T] {
+	if timeout <= 0 {
+		return builder
+	}
+
+	return bedrock.AppBuilderFunc[T](func(ctx context.Context, cfg T) (bedrock.App, error) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, ErrBuildTimeout)
+		defer cancel()
+
+		// app is only read after receiving from errCh
+		// so it is never accessed concurrently.
+		var app bedrock.App
+		errCh := async.Go(func() (err error) {
+			app, err = builder.Build(ctx, cfg)
+			return err
+		})
+
+		built := func(err error) (bedrock.App, error) {
+			if err != nil && context.Cause(ctx) == ErrBuildTimeout && errors.Is(err, context.DeadlineExceeded) {
+				return nil, ErrBuildTimeout
+			}
+			return app, err
+		}
+
+		select {
+		case err := <-errCh:
+			return built(err)
+		case <-ctx.Done():
+		}
+
+		// builder.Build may have returned at the same time ctx was cancelled,
+		// in which case select picks randomly, so prefer its result.
+		select {
+		case err := <-errCh:
+			return built(err)
+		default:
+		}
+		if context.Cause(ctx) == ErrBuildTimeout {
+			return nil, ErrBuildTimeout
+		}
+		return nil, ctx.Err()
+	})
+}
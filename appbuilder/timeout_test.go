@@ -0,0 +1,106 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package appbuilder
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/z5labs/bedrock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithTimeout(t *testing.T) {
+	t.Run("will return an error", func(t *testing.T) {
+		t.Run("if the builder does not return before the timeout", func(t *testing.T) {
+			release := make(chan struct{})
+			defer close(release)
+
+			builder := WithTimeout(bedrock.AppBuilderFunc[struct{}](func(ctx context.Context, cfg struct{}) (bedrock.App, error) {
+				<-release
+				return nil, nil
+			}), 10*time.Millisecond)
+
+			_, err := builder.Build(context.Background(), struct{}{})
+			if !assert.ErrorIs(t, err, ErrBuildTimeout) {
+				return
+			}
+		})
+
+		t.Run("if the builder respects the context deadline", func(t *testing.T) {
+			builder := WithTimeout(bedrock.AppBuilderFunc[struct{}](func(ctx context.Context, cfg struct{}) (bedrock.App, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}), 10*time.Millisecond)
+
+			_, err := builder.Build(context.Background(), struct{}{})
+			if !assert.ErrorIs(t, err, ErrBuildTimeout) {
+				return
+			}
+		})
+
+		t.Run("if the builder fails", func(t *testing.T) {
+			buildErr := errors.New("failed to build")
+			builder := WithTimeout(bedrock.AppBuilderFunc[struct{}](func(ctx context.Context, cfg struct{}) (bedrock.App, error) {
+				return nil, buildErr
+			}), time.Minute)
+
+			_, err := builder.Build(context.Background(), struct{}{})
+			if !assert.ErrorIs(t, err, buildErr) {
+				return
+			}
+		})
+
+		t.Run("if the builder panics", func(t *testing.T) {
+			builder := WithTimeout(bedrock.AppBuilderFunc[struct{}](func(ctx context.Context, cfg struct{}) (bedrock.App, error) {
+				panic("hello world")
+			}), time.Minute)
+
+			_, err := builder.Build(context.Background(), struct{}{})
+
+			var perr bedrock.PanicError
+			if !assert.ErrorAs(t, err, &perr) {
+				return
+			}
+		})
+
+		t.Run("if the parent context is cancelled", func(t *testing.T) {
+			builder := WithTimeout(bedrock.AppBuilderFunc[struct{}](func(ctx context.Context, cfg struct{}) (bedrock.App, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}), time.Minute)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			_, err := builder.Build(ctx, struct{}{})
+			if !assert.ErrorIs(t, err, context.Canceled) {
+				return
+			}
+		})
+	})
+
+	t.Run("will return the built app", func(t *testing.T) {
+		t.Run("if the builder returns before the timeout", func(t *testing.T) {
+			builder := WithTimeout(bedrock.AppBuilderFunc[struct{}](func(ctx context.Context, cfg struct{}) (bedrock.App, error) {
+				return appFunc(func(ctx context.Context) error {
+					return nil
+				}), nil
+			}), time.Minute)
+
+			app, err := builder.Build(context.Background(), struct{}{})
+			if !assert.Nil(t, err) {
+				return
+			}
+			if !assert.NotNil(t, app) {
+				return
+			}
+		})
+	})
+}
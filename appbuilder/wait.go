@@ -0,0 +1,206 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package appbuilder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/z5labs/bedrock"
+)
+
+// Dependency represents an external dependency, e.g. a database,
+// which must be reachable before a [bedrock.App] can be built.
+type Dependency struct {
+	// Name identifies the dependency in a [DependenciesNotReadyError].
+	Name string
+
+	// Check returns nil once the dependency is ready.
+	Check func(context.Context) error
+}
+
+// WaitForTCP returns a [Dependency] which is ready once
+// a TCP connection can be established to the given address.
+func WaitForTCP(addr string) Dependency {
+	return Dependency{
+		Name: addr,
+		Check: func(ctx context.Context) error {
+			var d net.Dialer
+			conn, err := d.DialContext(ctx, "tcp", addr)
+			if err != nil {
+				return err
+			}
+			return conn.Close()
+		},
+	}
+}
+
+// WaitForHTTP returns a [Dependency] which is ready once a GET
+// request to the given url returns a 2xx status code.
+func WaitForHTTP(url string) Dependency {
+	return Dependency{
+		Name: url,
+		Check: func(ctx context.Context) error {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+			if err != nil {
+				return err
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return err
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode < 200 || resp.StatusCode > 299 {
+				return fmt.Errorf("unexpected http status code: %d", resp.StatusCode)
+			}
+			return nil
+		},
+	}
+}
+
+// DefaultWaitTimeout is the default amount of time [WaitFor]
+// waits for all dependencies to become ready.
+const DefaultWaitTimeout = time.Minute
+
+// WaitOption represents options for configuring [WaitFor].
+type WaitOption func(*waitOptions)
+
+type waitOptions struct {
+	timeout    time.Duration
+	minBackoff time.Duration
+	maxBackoff time.Duration
+}
+
+// WaitTimeout sets the overall amount of time to wait for all dependencies
+// to become ready. A zero timeout means wait until the [context.Context]
+// passed to Build is cancelled.
+func WaitTimeout(d time.Duration) WaitOption {
+	return func(wo *waitOptions) {
+		wo.timeout = d
+	}
+}
+
+// WaitBackoff sets the delay between checks of a dependency. The delay starts
+// at initial and is doubled after each failed check, up to limit.
+func WaitBackoff(initial, limit time.Duration) WaitOption {
+	return func(wo *waitOptions) {
+		wo.minBackoff = initial
+		wo.maxBackoff = limit
+	}
+}
+
+// DependenciesNotReadyError occurs when one or more dependencies
+// did not become ready before [WaitFor] timed out.
+type DependenciesNotReadyError struct {
+	Names []string
+	Cause error
+}
+
+// Error implements the error interface.
+func (e DependenciesNotReadyError) Error() string {
+	return fmt.Sprintf("dependencies never became ready: %s: %s", strings.Join(e.Names, ", "), e.Cause)
+}
+
+// Unwrap implements the implicit interface used by errors.Is and errors.As.
+func (e DependenciesNotReadyError) Unwrap() error {
+	return e.Cause
+}
+
+// WaitFor wraps the given [bedrock.AppBuilder] such that builder.Build is only
+// called once all of the given dependencies are ready. Each dependency is checked
+// concurrently and retried with exponential backoff until it succeeds or the overall
+// timeout, [DefaultWaitTimeout] unless set with [WaitTimeout], elapses. This is
+// useful in environments, e.g. docker-compose, where dependencies may start after
+// the app does.
+//
+// If any dependency does not become ready, a [DependenciesNotReadyError] naming
+// them is returned and builder.Build is never called.
+func WaitFor[T any](builder bedrock.AppBuilder[T], deps []Dependency, opts ...WaitOption) bedrock.AppBuilder[T] {
+	wo := waitOptions{
+		timeout:    DefaultWaitTimeout,
+		minBackoff: 100 * time.Millisecond,
+		maxBackoff: 5 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(&wo)
+	}
+
+	return bedrock.AppBuilderFunc[T](func(ctx context.Context, cfg T) (bedrock.App, error) {
+		err := waitForDependencies(ctx, deps, wo)
+		if err != nil {
+			return nil, err
+		}
+		return builder.Build(ctx, cfg)
+	})
+}
+
+func waitForDependencies(ctx context.Context, deps []Dependency, wo waitOptions) error {
+	if wo.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, wo.timeout)
+		defer cancel()
+	}
+
+	errs := make([]error, len(deps))
+
+	var wg sync.WaitGroup
+	for i, dep := range deps {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer bedrock.Recover(&errs[i])
+
+			errs[i] = waitForDependency(ctx, dep, wo)
+		}()
+	}
+	wg.Wait()
+
+	var (
+		names  []string
+		causes []error
+	)
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		names = append(names, deps[i].Name)
+		causes = append(causes, fmt.Errorf("%s: %w", deps[i].Name, err))
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	return DependenciesNotReadyError{
+		Names: names,
+		Cause: errors.Join(causes...),
+	}
+}
+
+func waitForDependency(ctx context.Context, dep Dependency, wo waitOptions) error {
+	backoff := wo.minBackoff
+	for {
+		err := dep.Check(ctx)
+		if err == nil {
+			return nil
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+
+		backoff = min(2*backoff, wo.maxBackoff)
+	}
+}
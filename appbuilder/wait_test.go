@@ -0,0 +1,182 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package appbuilder
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/z5labs/bedrock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func unusedAddr(t *testing.T) string {
+	t.Helper()
+
+	ls, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ls.Addr().String()
+	ls.Close()
+	return addr
+}
+
+func TestWaitFor(t *testing.T) {
+	t.Run("will return an error", func(t *testing.T) {
+		t.Run("if a dependency never becomes ready", func(t *testing.T) {
+			addr := unusedAddr(t)
+
+			built := false
+			builder := WaitFor(
+				bedrock.AppBuilderFunc[struct{}](func(ctx context.Context, cfg struct{}) (bedrock.App, error) {
+					built = true
+					return nil, nil
+				}),
+				[]Dependency{
+					{
+						Name: "ready",
+						Check: func(ctx context.Context) error {
+							return nil
+						},
+					},
+					WaitForTCP(addr),
+				},
+				WaitTimeout(50*time.Millisecond),
+				WaitBackoff(time.Millisecond, 10*time.Millisecond),
+			)
+
+			_, err := builder.Build(context.Background(), struct{}{})
+
+			var derr DependenciesNotReadyError
+			if !assert.ErrorAs(t, err, &derr) {
+				return
+			}
+			if !assert.NotEmpty(t, derr.Error()) {
+				return
+			}
+			if !assert.Equal(t, []string{addr}, derr.Names) {
+				return
+			}
+			if !assert.False(t, built) {
+				return
+			}
+		})
+
+		t.Run("if the HTTP dependency does not return a 2xx status code", func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}))
+			defer srv.Close()
+
+			builder := WaitFor(
+				bedrock.AppBuilderFunc[struct{}](func(ctx context.Context, cfg struct{}) (bedrock.App, error) {
+					return nil, nil
+				}),
+				[]Dependency{WaitForHTTP(srv.URL)},
+				WaitTimeout(50*time.Millisecond),
+				WaitBackoff(time.Millisecond, 10*time.Millisecond),
+			)
+
+			_, err := builder.Build(context.Background(), struct{}{})
+
+			var derr DependenciesNotReadyError
+			if !assert.ErrorAs(t, err, &derr) {
+				return
+			}
+			if !assert.Equal(t, []string{srv.URL}, derr.Names) {
+				return
+			}
+		})
+
+		t.Run("if the underlying AppBuilder fails", func(t *testing.T) {
+			buildErr := errors.New("failed to build")
+			builder := WaitFor(
+				bedrock.AppBuilderFunc[struct{}](func(ctx context.Context, cfg struct{}) (bedrock.App, error) {
+					return nil, buildErr
+				}),
+				nil,
+			)
+
+			_, err := builder.Build(context.Background(), struct{}{})
+			if !assert.ErrorIs(t, err, buildErr) {
+				return
+			}
+		})
+	})
+
+	t.Run("will build the app", func(t *testing.T) {
+		t.Run("if a TCP dependency starts accepting connections after a delay", func(t *testing.T) {
+			addr := unusedAddr(t)
+
+			listening := make(chan net.Listener, 1)
+			go func() {
+				time.Sleep(30 * time.Millisecond)
+				ls, err := net.Listen("tcp", addr)
+				if err != nil {
+					listening <- nil
+					return
+				}
+				listening <- ls
+			}()
+			defer func() {
+				ls := <-listening
+				if ls != nil {
+					ls.Close()
+				}
+			}()
+
+			builder := WaitFor(
+				bedrock.AppBuilderFunc[struct{}](func(ctx context.Context, cfg struct{}) (bedrock.App, error) {
+					return appFunc(func(ctx context.Context) error {
+						return nil
+					}), nil
+				}),
+				[]Dependency{WaitForTCP(addr)},
+				WaitTimeout(5*time.Second),
+				WaitBackoff(time.Millisecond, 10*time.Millisecond),
+			)
+
+			app, err := builder.Build(context.Background(), struct{}{})
+			if !assert.Nil(t, err) {
+				return
+			}
+			if !assert.NotNil(t, app) {
+				return
+			}
+		})
+
+		t.Run("if a HTTP dependency returns a 2xx status code", func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer srv.Close()
+
+			builder := WaitFor(
+				bedrock.AppBuilderFunc[struct{}](func(ctx context.Context, cfg struct{}) (bedrock.App, error) {
+					return appFunc(func(ctx context.Context) error {
+						return nil
+					}), nil
+				}),
+				[]Dependency{WaitForHTTP(srv.URL)},
+			)
+
+			app, err := builder.Build(context.Background(), struct{}{})
+			if !assert.Nil(t, err) {
+				return
+			}
+			if !assert.NotNil(t, app) {
+				return
+			}
+		})
+	})
+}
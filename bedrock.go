@@ -9,6 +9,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"runtime/debug"
 
 	"github.com/z5labs/bedrock/config"
 )
@@ -35,6 +37,10 @@ func (f AppBuilderFunc[T]) Build(ctx context.Context, cfg T) (App, error) {
 // PanicError represents a value that was recovered from a panic.
 type PanicError struct {
 	Value any
+
+	// Stack is the stack trace of the panicking goroutine,
+	// as formatted by [debug.Stack], if it was captured.
+	Stack []byte
 }
 
 // Error implements the [error] interface.
@@ -42,6 +48,25 @@ func (e PanicError) Error() string {
 	return fmt.Sprintf("recovered from panic: %v", e.Value)
 }
 
+// Format implements the [fmt.Formatter] interface. The %+v verb
+// will include the stack trace of the panicking goroutine.
+func (e PanicError) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('+'):
+		io.WriteString(f, e.Error())
+		if len(e.Stack) > 0 {
+			io.WriteString(f, "\n")
+			f.Write(e.Stack)
+		}
+	case verb == 'v' || verb == 's':
+		io.WriteString(f, e.Error())
+	case verb == 'q':
+		fmt.Fprintf(f, "%q", e.Error())
+	default:
+		fmt.Fprintf(f, "%%!%c(bedrock.PanicError=%s)", verb, e.Error())
+	}
+}
+
 // Unwrap implements the interface used by [errors.Unwrap], [errors.Is] and [errors.As].
 func (e PanicError) Unwrap() error {
 	if e.Value == nil {
@@ -54,22 +79,42 @@ func (e PanicError) Unwrap() error {
 }
 
 // Recover calls [recover] and if a value is captured it will be wrapped
-// into a [PanicError]. The [PanicError] will then be joined with any
-// value, err, may reference. The joining is performed using [errors.Join].
+// into a [PanicError], along with the stack trace of the panicking goroutine.
+// If err references a non-nil error then the [PanicError] will be joined
+// with it using [errors.Join], otherwise the [PanicError] is set as is.
+//
+// Since [recover] returns nil when a goroutine is exiting due to [runtime.Goexit],
+// e.g. via [testing.T.FailNow], Recover does not interfere with it.
 func Recover(err *error) {
 	r := recover()
 	if r == nil {
 		return
 	}
-	*err = errors.Join(*err, PanicError{
+	perr := PanicError{
 		Value: r,
-	})
+		Stack: debug.Stack(),
+	}
+	if *err == nil {
+		*err = perr
+		return
+	}
+	*err = errors.Join(*err, perr)
 }
 
+// ErrNilApp is returned, wrapped in an [AppBuildError], by [Run]
+// if the [AppBuilder] returns a nil [App] without an error.
+var ErrNilApp = errors.New("app builder returned a nil app")
+
 // Run executes the application. It's responsible for reading the provided
 // config sources, unmarshalling them into the generic config type, using
 // the config and builder to build the users [App] and, lastly, running the
 // returned [App].
+//
+// Any returned error is wrapped in one of [ConfigReadError], [ConfigUnmarshalError],
+// [AppBuildError] or [AppRunError] depending on which phase failed, which can be
+// checked with [errors.As] e.g. to map failures to different exit codes. The only
+// exception is a panic, which is recovered and returned as a [PanicError] without
+// being wrapped in the phase it occurred in.
 func Run[T any](ctx context.Context, builder AppBuilder[T], srcs ...config.Source) (err error) {
 	defer Recover(&err)
 
@@ -88,6 +133,9 @@ func Run[T any](ctx context.Context, builder AppBuilder[T], srcs ...config.Sourc
 	if err != nil {
 		return AppBuildError{Cause: err}
 	}
+	if app == nil {
+		return AppBuildError{Cause: ErrNilApp}
+	}
 
 	err = app.Run(ctx)
 	if err != nil {
@@ -96,7 +144,7 @@ func Run[T any](ctx context.Context, builder AppBuilder[T], srcs ...config.Sourc
 	return nil
 }
 
-// ConfigReadError
+// ConfigReadError is returned by [Run] when one of the [config.Source]s fails to be read.
 type ConfigReadError struct {
 	Cause error
 }
@@ -111,7 +159,8 @@ func (e ConfigReadError) Unwrap() error {
 	return e.Cause
 }
 
-// ConfigUnmarshalError
+// ConfigUnmarshalError is returned by [Run] when the read config fails
+// to be unmarshalled into the custom config type.
 type ConfigUnmarshalError struct {
 	Cause error
 }
@@ -126,7 +175,7 @@ func (e ConfigUnmarshalError) Unwrap() error {
 	return e.Cause
 }
 
-// AppBuildError
+// AppBuildError is returned by [Run] when the [AppBuilder] fails to build the [App].
 type AppBuildError struct {
 	Cause error
 }
@@ -141,7 +190,7 @@ func (e AppBuildError) Unwrap() error {
 	return e.Cause
 }
 
-// AppRunError
+// AppRunError is returned by [Run] when the [App] fails to run.
 type AppRunError struct {
 	Cause error
 }
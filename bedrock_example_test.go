@@ -7,6 +7,7 @@ package bedrock
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -49,3 +50,22 @@ func ExampleRun() {
 	}
 	//Output: hello, world
 }
+
+func ExampleRun_errorPhases() {
+	type MyConfig struct{}
+
+	builder := AppBuilderFunc[MyConfig](func(ctx context.Context, cfg MyConfig) (App, error) {
+		return nil, errors.New("failed to connect to database")
+	})
+
+	err := Run(context.Background(), builder)
+
+	var buildErr AppBuildError
+	switch {
+	case errors.As(err, &buildErr):
+		fmt.Println("exit code 2:", buildErr.Cause)
+	case err != nil:
+		fmt.Println("exit code 1:", err)
+	}
+	// Output: exit code 2: failed to connect to database
+}
@@ -8,6 +8,7 @@ package bedrock
 import (
 	"context"
 	"errors"
+	"fmt"
 	"runtime"
 	"strings"
 	"testing"
@@ -24,7 +25,6 @@ func TestRecover(t *testing.T) {
 				defer Recover(&err)
 
 				panic(nil)
-				return nil
 			}
 
 			err := f()
@@ -48,7 +48,6 @@ func TestRecover(t *testing.T) {
 				defer Recover(&err)
 
 				panic("hello world")
-				return nil
 			}
 
 			err := f()
@@ -71,7 +70,6 @@ func TestRecover(t *testing.T) {
 				defer Recover(&err)
 
 				panic(panicErr)
-				return nil
 			}
 
 			err := f()
@@ -88,6 +86,25 @@ func TestRecover(t *testing.T) {
 			}
 		})
 
+		t.Run("if panic is called with a wrapped error type", func(t *testing.T) {
+			panicErr := errors.New("everybody panic!")
+			f := func() (err error) {
+				defer Recover(&err)
+
+				panic(fmt.Errorf("wrapped: %w", panicErr))
+			}
+
+			err := f()
+
+			var perr PanicError
+			if !assert.ErrorAs(t, err, &perr) {
+				return
+			}
+			if !assert.ErrorIs(t, err, panicErr) {
+				return
+			}
+		})
+
 		t.Run("even if the reference error already holds a value", func(t *testing.T) {
 			prePanicErr := errors.New("everybody panic!")
 			f := func() (err error) {
@@ -95,7 +112,6 @@ func TestRecover(t *testing.T) {
 
 				err = prePanicErr
 				panic("hello world")
-				return nil
 			}
 
 			err := f()
@@ -117,6 +133,78 @@ func TestRecover(t *testing.T) {
 			}
 		})
 	})
+
+	t.Run("will capture the stack trace", func(t *testing.T) {
+		t.Run("if a panic is recovered", func(t *testing.T) {
+			f := func() (err error) {
+				defer Recover(&err)
+
+				panic("hello world")
+			}
+
+			err := f()
+
+			var perr PanicError
+			if !assert.ErrorAs(t, err, &perr) {
+				return
+			}
+			if !assert.Contains(t, string(perr.Stack), "TestRecover") {
+				return
+			}
+			if !assert.NotContains(t, perr.Error(), string(perr.Stack)) {
+				return
+			}
+			if !assert.Equal(t, perr.Error(), fmt.Sprintf("%v", perr)) {
+				return
+			}
+			if !assert.Equal(t, perr.Error()+"\n"+string(perr.Stack), fmt.Sprintf("%+v", perr)) {
+				return
+			}
+		})
+
+		t.Run("if the App passed to Run panics", func(t *testing.T) {
+			type myConfig struct{}
+
+			b := AppBuilderFunc[myConfig](func(ctx context.Context, cfg myConfig) (App, error) {
+				app := appFunc(func(ctx context.Context) error {
+					panic("hello world")
+				})
+				return app, nil
+			})
+
+			err := Run(context.Background(), b)
+			if !assert.Contains(t, fmt.Sprintf("%+v", err), "TestRecover") {
+				return
+			}
+		})
+	})
+
+	t.Run("will not catch runtime.Goexit", func(t *testing.T) {
+		var err error
+		returned := false
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+
+			f := func() (err error) {
+				defer Recover(&err)
+
+				runtime.Goexit()
+				return nil
+			}
+
+			err = f()
+			returned = true
+		}()
+
+		<-done
+		if !assert.False(t, returned) {
+			return
+		}
+		if !assert.Nil(t, err) {
+			return
+		}
+	})
 }
 
 type configSourceFunc func(config.Store) error
@@ -206,6 +294,47 @@ func TestRun(t *testing.T) {
 			}
 		})
 
+		t.Run("if the AppBuilder returns a nil App", func(t *testing.T) {
+			type myConfig struct{}
+
+			b := AppBuilderFunc[myConfig](func(ctx context.Context, cfg myConfig) (App, error) {
+				return nil, nil
+			})
+
+			err := Run(context.Background(), b)
+
+			var ierr AppBuildError
+			if !assert.ErrorAs(t, err, &ierr) {
+				return
+			}
+			if !assert.ErrorIs(t, ierr, ErrNilApp) {
+				return
+			}
+		})
+
+		t.Run("if the AppBuilder panics", func(t *testing.T) {
+			type myConfig struct{}
+
+			b := AppBuilderFunc[myConfig](func(ctx context.Context, cfg myConfig) (App, error) {
+				panic("hello world")
+			})
+
+			err := Run(context.Background(), b)
+
+			var perr PanicError
+			if !assert.ErrorAs(t, err, &perr) {
+				return
+			}
+			if !assert.Equal(t, "hello world", perr.Value) {
+				return
+			}
+
+			var ierr AppBuildError
+			if !assert.False(t, errors.As(err, &ierr)) {
+				return
+			}
+		})
+
 		t.Run("if the App fails to run", func(t *testing.T) {
 			type myConfig struct {
 				Value string `config:"value"`
@@ -10,6 +10,7 @@ import (
 	"encoding"
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"time"
 
@@ -62,6 +63,7 @@ func (m *Manager) Unmarshal(v any) error {
 		DecodeHook: composeDecodeHooks(
 			textUnmarshalerHookFunc(),
 			timeDurationHookFunc(),
+			integerHookFunc(),
 		),
 	})
 	if err != nil {
@@ -145,3 +147,106 @@ func timeDurationHookFunc() mapstructure.DecodeHookFuncType {
 		}
 	}
 }
+
+// IntegerOverflowError occurs when a numeric config value
+// does not fit in the integer type it is being unmarshalled to.
+type IntegerOverflowError struct {
+	Value any
+	Type  string
+}
+
+// Error implements the error interface.
+func (e IntegerOverflowError) Error() string {
+	return fmt.Sprintf("value overflows %s: %v", e.Type, e.Value)
+}
+
+// FractionalValueError occurs when a float config value with a fractional
+// part is unmarshalled to an integer type, which would silently truncate it.
+type FractionalValueError struct {
+	Value float64
+	Type  string
+}
+
+// Error implements the error interface.
+func (e FractionalValueError) Error() string {
+	return fmt.Sprintf("value with fractional part can not be represented as %s: %v", e.Type, e.Value)
+}
+
+func integerHookFunc() mapstructure.DecodeHookFuncType {
+	return func(f reflect.Type, t reflect.Type, data any) (any, error) {
+		toInt := isIntKind(t.Kind())
+		if !toInt && !isUintKind(t.Kind()) {
+			return nil, errInvalidDecodeCondition
+		}
+
+		v := reflect.ValueOf(data)
+		out := reflect.New(t).Elem()
+		overflow := IntegerOverflowError{Value: data, Type: t.String()}
+		switch {
+		case isIntKind(f.Kind()):
+			i := v.Int()
+			if toInt {
+				if out.OverflowInt(i) {
+					return nil, overflow
+				}
+				out.SetInt(i)
+				break
+			}
+			if i < 0 || out.OverflowUint(uint64(i)) {
+				return nil, overflow
+			}
+			out.SetUint(uint64(i))
+		case isUintKind(f.Kind()):
+			u := v.Uint()
+			if toInt {
+				if u > math.MaxInt64 || out.OverflowInt(int64(u)) {
+					return nil, overflow
+				}
+				out.SetInt(int64(u))
+				break
+			}
+			if out.OverflowUint(u) {
+				return nil, overflow
+			}
+			out.SetUint(u)
+		case f.Kind() == reflect.Float32 || f.Kind() == reflect.Float64:
+			fl := v.Float()
+			if fl != math.Trunc(fl) {
+				return nil, FractionalValueError{Value: fl, Type: t.String()}
+			}
+			if toInt {
+				// float64(math.MaxInt64) rounds up to 2^63 so it must be excluded.
+				if fl < math.MinInt64 || fl >= math.MaxInt64 || out.OverflowInt(int64(fl)) {
+					return nil, overflow
+				}
+				out.SetInt(int64(fl))
+				break
+			}
+			if fl < 0 || fl >= math.MaxUint64 || out.OverflowUint(uint64(fl)) {
+				return nil, overflow
+			}
+			out.SetUint(uint64(fl))
+		default:
+			return nil, errInvalidDecodeCondition
+		}
+		return out.Interface(), nil
+	}
+}
+
+func isIntKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	default:
+		return false
+	}
+}
+
+func isUintKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	default:
+		return false
+	}
+}
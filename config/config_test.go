@@ -7,6 +7,7 @@ package config
 
 import (
 	"errors"
+	"math"
 	"strconv"
 	"strings"
 	"testing"
@@ -136,6 +137,150 @@ func TestManager_Unmarshal(t *testing.T) {
 				return
 			}
 		})
+
+		testCases := []struct {
+			Name   string
+			Source Source
+			Check  func(*testing.T, error)
+		}{
+			{
+				Name:   "if a uint64 value overflows an int64",
+				Source: FromYaml(strings.NewReader(`n: 18446744073709551615`)),
+				Check: func(t *testing.T, err error) {
+					var oerr IntegerOverflowError
+					if !assert.ErrorAs(t, err, &oerr) {
+						return
+					}
+					assert.NotEmpty(t, oerr.Error())
+				},
+			},
+			{
+				Name:   "if a float value overflows an int64",
+				Source: FromJson(strings.NewReader(`{"n": 1e19}`)),
+				Check: func(t *testing.T, err error) {
+					var oerr IntegerOverflowError
+					assert.ErrorAs(t, err, &oerr)
+				},
+			},
+			{
+				Name:   "if a float value has a fractional part",
+				Source: FromYaml(strings.NewReader(`n: 1.5e0`)),
+				Check: func(t *testing.T, err error) {
+					var ferr FractionalValueError
+					if !assert.ErrorAs(t, err, &ferr) {
+						return
+					}
+					assert.NotEmpty(t, ferr.Error())
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.Name, func(t *testing.T) {
+				m, err := Read(testCase.Source)
+				if !assert.Nil(t, err) {
+					return
+				}
+
+				var cfg struct {
+					N int64 `config:"n"`
+				}
+				err = m.Unmarshal(&cfg)
+				testCase.Check(t, err)
+			})
+		}
+
+		t.Run("if a negative value is unmarshalled to a uint64", func(t *testing.T) {
+			m, err := Read(FromYaml(strings.NewReader(`n: -1`)))
+			if !assert.Nil(t, err) {
+				return
+			}
+
+			var cfg struct {
+				N uint64 `config:"n"`
+			}
+			err = m.Unmarshal(&cfg)
+
+			var oerr IntegerOverflowError
+			if !assert.ErrorAs(t, err, &oerr) {
+				return
+			}
+		})
+
+		t.Run("if an int value overflows an int8", func(t *testing.T) {
+			m, err := Read(FromYaml(strings.NewReader(`n: 300`)))
+			if !assert.Nil(t, err) {
+				return
+			}
+
+			var cfg struct {
+				N int8 `config:"n"`
+			}
+			err = m.Unmarshal(&cfg)
+
+			var oerr IntegerOverflowError
+			if !assert.ErrorAs(t, err, &oerr) {
+				return
+			}
+		})
+	})
+
+	t.Run("will preserve integer precision", func(t *testing.T) {
+		testCases := []struct {
+			Name   string
+			Source Source
+			Int64  int64
+			Uint64 uint64
+		}{
+			{
+				Name:   "if the YAML values are at their max",
+				Source: FromYaml(strings.NewReader("i: 9223372036854775807\nu: 18446744073709551615")),
+				Int64:  math.MaxInt64,
+				Uint64: math.MaxUint64,
+			},
+			{
+				Name:   "if the JSON values are at their max",
+				Source: FromJson(strings.NewReader(`{"i": 9223372036854775807, "u": 18446744073709551615}`)),
+				Int64:  math.MaxInt64,
+				Uint64: math.MaxUint64,
+			},
+			{
+				Name:   "if the YAML int64 value is negative",
+				Source: FromYaml(strings.NewReader("i: -9223372036854775808\nu: 0")),
+				Int64:  math.MinInt64,
+				Uint64: 0,
+			},
+			{
+				Name:   "if the JSON values are in scientific notation",
+				Source: FromJson(strings.NewReader(`{"i": -1e3, "u": 1e3}`)),
+				Int64:  -1000,
+				Uint64: 1000,
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.Name, func(t *testing.T) {
+				m, err := Read(testCase.Source)
+				if !assert.Nil(t, err) {
+					return
+				}
+
+				var cfg struct {
+					Int64  int64  `config:"i"`
+					Uint64 uint64 `config:"u"`
+				}
+				err = m.Unmarshal(&cfg)
+				if !assert.Nil(t, err) {
+					return
+				}
+				if !assert.Equal(t, testCase.Int64, cfg.Int64) {
+					return
+				}
+				if !assert.Equal(t, testCase.Uint64, cfg.Uint64) {
+					return
+				}
+			})
+		}
 	})
 
 	t.Run("will unmarshal time.Duration", func(t *testing.T) {
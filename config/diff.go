@@ -0,0 +1,260 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package config
+
+import (
+	"cmp"
+	"fmt"
+	"path"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ChangeKind describes how a config value changed between two [Manager]s.
+type ChangeKind int
+
+const (
+	// Added means the key is only present in the new [Manager].
+	Added ChangeKind = iota
+
+	// Removed means the key is only present in the old [Manager].
+	Removed
+
+	// Modified means the key is present in both [Manager]s but its value differs.
+	Modified
+)
+
+// String implements the [fmt.Stringer] interface.
+func (k ChangeKind) String() string {
+	switch k {
+	case Added:
+		return "added"
+	case Removed:
+		return "removed"
+	case Modified:
+		return "modified"
+	default:
+		return fmt.Sprintf("ChangeKind(%d)", int(k))
+	}
+}
+
+// Change represents a single config value which differs between two [Manager]s.
+// Key is the full path to the value where nested keys are joined by "." and slice
+// elements are referenced by their index e.g. "servers[1].port".
+type Change struct {
+	Key  string
+	Kind ChangeKind
+	Old  any
+	New  any
+}
+
+// String implements the [fmt.Stringer] interface.
+func (c Change) String() string {
+	switch c.Kind {
+	case Added:
+		return fmt.Sprintf("%s: added %v", c.Key, c.New)
+	case Removed:
+		return fmt.Sprintf("%s: removed %v", c.Key, c.Old)
+	default:
+		return fmt.Sprintf("%s: %v -> %v", c.Key, c.Old, c.New)
+	}
+}
+
+// Redacted is the value reported in a [Change] for keys which have been redacted.
+const Redacted = "[REDACTED]"
+
+// DiffOption represents options for configuring [Diff].
+type DiffOption func(*differ)
+
+// RedactKeys replaces the old and new values of any [Change] whose key matches
+// one of the given patterns with [Redacted]. Patterns use the syntax of [path.Match]
+// with "." treated as the separator e.g. "db.password" or "*.token".
+func RedactKeys(patterns ...string) DiffOption {
+	return func(d *differ) {
+		d.redact = append(d.redact, patterns...)
+	}
+}
+
+type differ struct {
+	redact  []string
+	changes []Change
+}
+
+// Diff computes the changes from the old [Manager] to the new [Manager].
+// Nested maps and slices are compared deeply so only the leaf values which
+// actually changed are reported. The returned changes are sorted by key.
+func Diff(old, new *Manager, opts ...DiffOption) []Change {
+	d := &differ{}
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	d.diffMaps("", storeMap(old), storeMap(new))
+
+	slices.SortFunc(d.changes, func(a, b Change) int {
+		return compareKeys(a.Key, b.Key)
+	})
+	return d.changes
+}
+
+// compareKeys compares keys segment by segment so slice indices are
+// ordered numerically, e.g. "a[2]" sorts before "a[10]".
+func compareKeys(a, b string) int {
+	as := keySegments(a)
+	bs := keySegments(b)
+	for i := range min(len(as), len(bs)) {
+		ai, aErr := sliceIndex(as[i])
+		bi, bErr := sliceIndex(bs[i])
+		if aErr == nil && bErr == nil {
+			if c := cmp.Compare(ai, bi); c != 0 {
+				return c
+			}
+			continue
+		}
+		if c := strings.Compare(as[i], bs[i]); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(as), len(bs))
+}
+
+func keySegments(k string) []string {
+	return strings.Split(strings.ReplaceAll(k, "[", ".["), ".")
+}
+
+func sliceIndex(segment string) (int, error) {
+	if !strings.HasPrefix(segment, "[") || !strings.HasSuffix(segment, "]") {
+		return 0, strconv.ErrSyntax
+	}
+	return strconv.Atoi(segment[1 : len(segment)-1])
+}
+
+func storeMap(m *Manager) map[string]any {
+	if m == nil {
+		return nil
+	}
+	store, ok := m.store.(Map)
+	if !ok {
+		return nil
+	}
+	return store
+}
+
+func (d *differ) diffMaps(prefix string, old, new map[string]any) {
+	for k, oldV := range old {
+		newV, ok := new[k]
+		if !ok {
+			d.removed(joinKey(prefix, k), oldV)
+			continue
+		}
+		d.diff(joinKey(prefix, k), oldV, newV)
+	}
+	for k, newV := range new {
+		if _, ok := old[k]; ok {
+			continue
+		}
+		d.added(joinKey(prefix, k), newV)
+	}
+}
+
+func (d *differ) diffSlices(prefix string, old, new []any) {
+	for i := range max(len(old), len(new)) {
+		k := prefix + "[" + strconv.Itoa(i) + "]"
+		switch {
+		case i >= len(new):
+			d.removed(k, old[i])
+		case i >= len(old):
+			d.added(k, new[i])
+		default:
+			d.diff(k, old[i], new[i])
+		}
+	}
+}
+
+func (d *differ) diff(k string, old, new any) {
+	switch oldV := old.(type) {
+	case map[string]any:
+		if newV, ok := new.(map[string]any); ok {
+			d.diffMaps(k, oldV, newV)
+			return
+		}
+	case []any:
+		if newV, ok := new.([]any); ok {
+			d.diffSlices(k, oldV, newV)
+			return
+		}
+	}
+	if reflect.DeepEqual(old, new) {
+		return
+	}
+	d.record(Change{Key: k, Kind: Modified, Old: old, New: new})
+}
+
+func (d *differ) added(k string, v any) {
+	d.record(Change{Key: k, Kind: Added, New: v})
+}
+
+func (d *differ) removed(k string, v any) {
+	d.record(Change{Key: k, Kind: Removed, Old: v})
+}
+
+func (d *differ) record(c Change) {
+	if c.Old != nil {
+		c.Old = d.redactValue(c.Key, c.Old)
+	}
+	if c.New != nil {
+		c.New = d.redactValue(c.Key, c.New)
+	}
+	d.changes = append(d.changes, c)
+}
+
+// redactValue returns a copy of v where every value, including v itself, whose
+// key matches a redaction pattern is replaced with [Redacted]. This ensures
+// secrets do not leak when a whole map or slice containing them changes.
+func (d *differ) redactValue(k string, v any) any {
+	if d.redacted(k) {
+		return Redacted
+	}
+
+	switch x := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(x))
+		for subK, subV := range x {
+			m[subK] = d.redactValue(joinKey(k, subK), subV)
+		}
+		return m
+	case []any:
+		vs := make([]any, len(x))
+		for i, subV := range x {
+			vs[i] = d.redactValue(k+"["+strconv.Itoa(i)+"]", subV)
+		}
+		return vs
+	default:
+		return v
+	}
+}
+
+func (d *differ) redacted(k string) bool {
+	// path.Match treats "/" as the separator so swap it in for "."
+	// to keep "*" from matching across nested keys.
+	name := strings.ReplaceAll(k, ".", "/")
+	for _, pattern := range d.redact {
+		matched, err := path.Match(strings.ReplaceAll(pattern, ".", "/"), name)
+		if err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+func joinKey(prefix, k string) string {
+	if prefix == "" {
+		return k
+	}
+	return prefix + "." + k
+}
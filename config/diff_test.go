@@ -0,0 +1,174 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func readYaml(t *testing.T, s string) *Manager {
+	t.Helper()
+
+	m, err := Read(FromYaml(strings.NewReader(s)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestDiff(t *testing.T) {
+	t.Run("will return no changes", func(t *testing.T) {
+		t.Run("if both managers contain the same values", func(t *testing.T) {
+			old := readYaml(t, "a:\n  b: [1, 2]\n  c: hello")
+			new := readYaml(t, "a:\n  c: hello\n  b: [1, 2]")
+
+			changes := Diff(old, new)
+			if !assert.Empty(t, changes) {
+				return
+			}
+		})
+	})
+
+	t.Run("will return changes", func(t *testing.T) {
+		testCases := []struct {
+			Name    string
+			Old     string
+			New     string
+			Changes []Change
+		}{
+			{
+				Name: "if a nested key is added",
+				Old:  "a:\n  b: 1",
+				New:  "a:\n  b: 1\n  c: 2",
+				Changes: []Change{
+					{Key: "a.c", Kind: Added, New: 2},
+				},
+			},
+			{
+				Name: "if a nested key is removed",
+				Old:  "a:\n  b: 1\n  c: 2",
+				New:  "a:\n  b: 1",
+				Changes: []Change{
+					{Key: "a.c", Kind: Removed, Old: 2},
+				},
+			},
+			{
+				Name: "if a value changes type",
+				Old:  "a: 1",
+				New:  "a:\n  b: 1",
+				Changes: []Change{
+					{Key: "a", Kind: Modified, Old: 1, New: map[string]any{"b": 1}},
+				},
+			},
+			{
+				Name: "if slice elements change",
+				Old:  "a: [1, 2, 3]",
+				New:  "a: [1, 4]",
+				Changes: []Change{
+					{Key: "a[1]", Kind: Modified, Old: 2, New: 4},
+					{Key: "a[2]", Kind: Removed, Old: 3},
+				},
+			},
+			{
+				Name: "if a value nested in a slice changes",
+				Old:  "servers:\n  - port: 8080",
+				New:  "servers:\n  - port: 9090",
+				Changes: []Change{
+					{Key: "servers[0].port", Kind: Modified, Old: 8080, New: 9090},
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.Name, func(t *testing.T) {
+				changes := Diff(readYaml(t, testCase.Old), readYaml(t, testCase.New))
+				if !assert.Equal(t, testCase.Changes, changes) {
+					return
+				}
+			})
+		}
+	})
+
+	t.Run("will sort changes", func(t *testing.T) {
+		t.Run("if slice indices have a different number of digits", func(t *testing.T) {
+			old := readYaml(t, "a: [0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0]")
+			new := readYaml(t, "a: [0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1]")
+
+			changes := Diff(old, new)
+
+			expected := []Change{
+				{Key: "a[2]", Kind: Modified, Old: 0, New: 1},
+				{Key: "a[10]", Kind: Modified, Old: 0, New: 1},
+			}
+			if !assert.Equal(t, expected, changes) {
+				return
+			}
+		})
+	})
+
+	t.Run("will redact values", func(t *testing.T) {
+		t.Run("if the key matches a redaction pattern", func(t *testing.T) {
+			old := readYaml(t, "db:\n  password: hunter2\n  host: a")
+			new := readYaml(t, "db:\n  password: hunter3\n  host: b\n  token: abc")
+
+			changes := Diff(old, new, RedactKeys("db.password", "*.token"))
+
+			expected := []Change{
+				{Key: "db.host", Kind: Modified, Old: "a", New: "b"},
+				{Key: "db.password", Kind: Modified, Old: Redacted, New: Redacted},
+				{Key: "db.token", Kind: Added, New: Redacted},
+			}
+			if !assert.Equal(t, expected, changes) {
+				return
+			}
+		})
+
+		t.Run("if a map containing a matching key is added", func(t *testing.T) {
+			old := readYaml(t, "host: a")
+			new := readYaml(t, "host: a\ndb:\n  password: hunter2\n  host: b")
+
+			changes := Diff(old, new, RedactKeys("db.password"))
+
+			expected := []Change{
+				{Key: "db", Kind: Added, New: map[string]any{"password": Redacted, "host": "b"}},
+			}
+			if !assert.Equal(t, expected, changes) {
+				return
+			}
+		})
+
+		t.Run("if a map containing a matching key is replaced by a scalar", func(t *testing.T) {
+			old := readYaml(t, "db:\n  password: hunter2")
+			new := readYaml(t, "db: disabled")
+
+			changes := Diff(old, new, RedactKeys("db.password"))
+
+			expected := []Change{
+				{Key: "db", Kind: Modified, Old: map[string]any{"password": Redacted}, New: "disabled"},
+			}
+			if !assert.Equal(t, expected, changes) {
+				return
+			}
+		})
+
+		t.Run("if a list containing a matching key is removed", func(t *testing.T) {
+			old := readYaml(t, "users:\n  - name: a\n    token: abc")
+			new := readYaml(t, "{}")
+
+			changes := Diff(old, new, RedactKeys("*.token"))
+
+			expected := []Change{
+				{Key: "users", Kind: Removed, Old: []any{map[string]any{"name": "a", "token": Redacted}}},
+			}
+			if !assert.Equal(t, expected, changes) {
+				return
+			}
+		})
+	})
+}
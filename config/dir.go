@@ -0,0 +1,81 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package config
+
+import (
+	"io/fs"
+	"path"
+	"strings"
+)
+
+// DirOption represents options for configuring a [Dir] source.
+type DirOption func(*Dir)
+
+// DirGlob configures the [Dir] source to only apply files whose
+// name matches the given pattern, see [path.Match] for its syntax.
+func DirGlob(pattern string) DirOption {
+	return func(d *Dir) {
+		d.pattern = pattern
+	}
+}
+
+// Dir represents a Source where its underlying values are read from
+// every file in a directory, e.g. a Kubernetes ConfigMap mounted as one.
+type Dir struct {
+	fs      fs.FS
+	dir     string
+	pattern string
+}
+
+// FromDir returns a source which will apply its config from every file in the
+// given directory, in lexical order, so values from later files override those
+// from earlier ones. Each file is applied like [FromFile] and must therefore have
+// one of its supported extensions, use [DirGlob] to ignore others.
+//
+// Hidden files and directories are always ignored, which includes the ..data
+// symlink and timestamped directories Kubernetes uses to atomically update
+// a mounted ConfigMap.
+func FromDir(fs fs.FS, dir string, opts ...DirOption) Dir {
+	d := Dir{
+		fs:      fs,
+		dir:     dir,
+		pattern: "*",
+	}
+	for _, opt := range opts {
+		opt(&d)
+	}
+	return d
+}
+
+// Apply implements the Source interface.
+func (src Dir) Apply(store Store) error {
+	// fs.Glob returns the matching paths in lexical order.
+	paths, err := fs.Glob(src.fs, path.Join(src.dir, src.pattern))
+	if err != nil {
+		return err
+	}
+
+	for _, p := range paths {
+		if strings.HasPrefix(path.Base(p), ".") {
+			continue
+		}
+
+		// fs.Stat follows symlinks, which Kubernetes uses for each file.
+		info, err := fs.Stat(src.fs, p)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			continue
+		}
+
+		err = FromFile(src.fs, p).Apply(store)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
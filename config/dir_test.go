@@ -0,0 +1,142 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDir_Apply(t *testing.T) {
+	t.Run("will return an error", func(t *testing.T) {
+		t.Run("if a file has an unknown extension", func(t *testing.T) {
+			fsys := fstest.MapFS{
+				"conf.d/a.yaml":    {Data: []byte("a: 1")},
+				"conf.d/README.md": {Data: []byte("# hello")},
+			}
+
+			err := FromDir(fsys, "conf.d").Apply(make(Map))
+
+			var uerr UnknownFileExtensionError
+			if !assert.ErrorAs(t, err, &uerr) {
+				return
+			}
+		})
+
+		t.Run("if the glob pattern is malformed", func(t *testing.T) {
+			err := FromDir(fstest.MapFS{}, "conf.d", DirGlob("[")).Apply(make(Map))
+			if !assert.Error(t, err) {
+				return
+			}
+		})
+	})
+
+	t.Run("will apply files in lexical order", func(t *testing.T) {
+		t.Run("if multiple files set the same key", func(t *testing.T) {
+			fsys := fstest.MapFS{
+				"conf.d/10-base.yaml":     {Data: []byte("a: base\nb: base")},
+				"conf.d/20-override.json": {Data: []byte(`{"b": "override"}`)},
+				"conf.d/sub/c.yaml":       {Data: []byte("c: sub")},
+			}
+
+			m, err := Read(FromDir(fsys, "conf.d"))
+			if !assert.Nil(t, err) {
+				return
+			}
+
+			var cfg struct {
+				A string `config:"a"`
+				B string `config:"b"`
+				C string `config:"c"`
+			}
+			err = m.Unmarshal(&cfg)
+			if !assert.Nil(t, err) {
+				return
+			}
+			if !assert.Equal(t, "base", cfg.A) {
+				return
+			}
+			if !assert.Equal(t, "override", cfg.B) {
+				return
+			}
+			if !assert.Empty(t, cfg.C) {
+				return
+			}
+		})
+	})
+
+	t.Run("will ignore files", func(t *testing.T) {
+		t.Run("if they do not match the glob pattern", func(t *testing.T) {
+			fsys := fstest.MapFS{
+				"conf.d/a.yaml":    {Data: []byte("a: 1")},
+				"conf.d/README.md": {Data: []byte("# hello")},
+			}
+
+			m := make(Map)
+			err := FromDir(fsys, "conf.d", DirGlob("*.yaml")).Apply(m)
+			if !assert.Nil(t, err) {
+				return
+			}
+			if !assert.Equal(t, Map{"a": 1}, m) {
+				return
+			}
+		})
+	})
+
+	t.Run("will ignore hidden files", func(t *testing.T) {
+		t.Run("if the directory uses the Kubernetes atomic update layout", func(t *testing.T) {
+			dir := t.TempDir()
+			mkfile := func(name, data string) {
+				err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o600)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			symlink := func(target, name string) {
+				err := os.Symlink(target, filepath.Join(dir, name))
+				if err != nil {
+					// e.g. Windows without the required privileges.
+					t.Skipf("symlinks are not supported: %s", err)
+				}
+			}
+
+			err := os.Mkdir(filepath.Join(dir, "..2024_01_01_00_00_00.1"), 0o700)
+			if err != nil {
+				t.Fatal(err)
+			}
+			mkfile("..2024_01_01_00_00_00.1/app.yaml", "a: 1")
+			mkfile("..2024_01_01_00_00_00.1/db.json", `{"b": 2}`)
+			mkfile(".hidden.yaml", "a: hidden")
+			symlink("..2024_01_01_00_00_00.1", "..data")
+			symlink("..data/app.yaml", "app.yaml")
+			symlink("..data/db.json", "db.json")
+
+			m, err := Read(FromDir(os.DirFS(dir), "."))
+			if !assert.Nil(t, err) {
+				return
+			}
+
+			var cfg struct {
+				A int `config:"a"`
+				B int `config:"b"`
+			}
+			err = m.Unmarshal(&cfg)
+			if !assert.Nil(t, err) {
+				return
+			}
+			if !assert.Equal(t, 1, cfg.A) {
+				return
+			}
+			if !assert.Equal(t, 2, cfg.B) {
+				return
+			}
+		})
+	})
+}
@@ -6,8 +6,11 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"io"
 	"io/fs"
+	"path"
 	"sync"
 )
 
@@ -50,3 +53,84 @@ func (r *FileReader) Close() error {
 	r.file = nil
 	return err
 }
+
+// FileOption represents options for configuring a [File] source.
+type FileOption func(*File)
+
+// IgnoreMissingFile configures the [File] source to apply no
+// config, instead of failing, if the file does not exist. This is
+// useful for optional override files.
+func IgnoreMissingFile() FileOption {
+	return func(f *File) {
+		f.ignoreMissing = true
+	}
+}
+
+// RenderFileTemplate configures the [File] source to render the file
+// contents as a text/template, see [RenderTextTemplate], before parsing it.
+func RenderFileTemplate(opts ...RenderTextTemplateOption) FileOption {
+	return func(f *File) {
+		f.renderTemplate = true
+		f.templateOpts = opts
+	}
+}
+
+// File represents a Source where its underlying values are read from a
+// file whose format is inferred from its extension.
+type File struct {
+	fs   fs.FS
+	path string
+
+	ignoreMissing  bool
+	renderTemplate bool
+	templateOpts   []RenderTextTemplateOption
+}
+
+// FromFile returns a source which will apply its config from the file at the
+// given path. The file is only opened when the source is applied and its format
+// is inferred from its extension, which must be one of: .yaml, .yml or .json.
+func FromFile(fs fs.FS, path string, opts ...FileOption) File {
+	f := File{
+		fs:   fs,
+		path: path,
+	}
+	for _, opt := range opts {
+		opt(&f)
+	}
+	return f
+}
+
+// UnknownFileExtensionError occurs when the format of a config
+// file can not be inferred from its extension.
+type UnknownFileExtensionError struct {
+	Path string
+}
+
+// Error implements the error interface.
+func (e UnknownFileExtensionError) Error() string {
+	return fmt.Sprintf("unable to infer config format from file extension: %s", e.Path)
+}
+
+// Apply implements the Source interface.
+func (src File) Apply(store Store) error {
+	var r io.Reader = NewFileReader(src.fs, src.path)
+	if src.renderTemplate {
+		r = RenderTextTemplate(r, src.templateOpts...)
+	}
+
+	var s Source
+	switch path.Ext(src.path) {
+	case ".yaml", ".yml":
+		s = FromYaml(r)
+	case ".json":
+		s = FromJson(r)
+	default:
+		return UnknownFileExtensionError{Path: src.path}
+	}
+
+	err := s.Apply(store)
+	if src.ignoreMissing && errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
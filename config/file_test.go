@@ -10,6 +10,7 @@ import (
 	"io"
 	"io/fs"
 	"testing"
+	"testing/fstest"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -52,3 +53,100 @@ func TestFileReader_Close(t *testing.T) {
 		})
 	})
 }
+
+func TestFile_Apply(t *testing.T) {
+	t.Run("will return an error", func(t *testing.T) {
+		t.Run("if the file does not exist", func(t *testing.T) {
+			src := FromFile(fstest.MapFS{}, "config.yaml")
+
+			err := src.Apply(make(Map))
+			if !assert.ErrorIs(t, err, fs.ErrNotExist) {
+				return
+			}
+		})
+
+		t.Run("if the file extension is unknown", func(t *testing.T) {
+			fsys := fstest.MapFS{
+				"config.toml": &fstest.MapFile{Data: []byte(`hello = "world"`)},
+			}
+			src := FromFile(fsys, "config.toml")
+
+			err := src.Apply(make(Map))
+
+			var uerr UnknownFileExtensionError
+			if !assert.ErrorAs(t, err, &uerr) {
+				return
+			}
+			if !assert.NotEmpty(t, uerr.Error()) {
+				return
+			}
+		})
+	})
+
+	t.Run("will not return an error", func(t *testing.T) {
+		t.Run("if the file does not exist and missing files are ignored", func(t *testing.T) {
+			src := FromFile(fstest.MapFS{}, "config.yaml", IgnoreMissingFile())
+
+			m := make(Map)
+			err := src.Apply(m)
+			if !assert.Nil(t, err) {
+				return
+			}
+			if !assert.Empty(t, m) {
+				return
+			}
+		})
+	})
+
+	t.Run("will apply the file contents", func(t *testing.T) {
+		testCases := []struct {
+			Name string
+			Path string
+			Data string
+			Opts []FileOption
+		}{
+			{
+				Name: "if the file is YAML",
+				Path: "config.yaml",
+				Data: `hello: world`,
+			},
+			{
+				Name: "if the file is YAML with a .yml extension",
+				Path: "config.yml",
+				Data: `hello: world`,
+			},
+			{
+				Name: "if the file is JSON",
+				Path: "config.json",
+				Data: `{"hello": "world"}`,
+			},
+			{
+				Name: "if the file is a template",
+				Path: "config.yaml",
+				Data: `hello: {{name}}`,
+				Opts: []FileOption{
+					RenderFileTemplate(TemplateFunc("name", func() string {
+						return "world"
+					})),
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.Name, func(t *testing.T) {
+				fsys := fstest.MapFS{
+					testCase.Path: &fstest.MapFile{Data: []byte(testCase.Data)},
+				}
+
+				m := make(Map)
+				err := FromFile(fsys, testCase.Path, testCase.Opts...).Apply(m)
+				if !assert.Nil(t, err) {
+					return
+				}
+				if !assert.Equal(t, Map{"hello": "world"}, m) {
+					return
+				}
+			})
+		}
+	})
+}
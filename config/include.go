@@ -0,0 +1,176 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package config
+
+import (
+	"fmt"
+	"io/fs"
+	"slices"
+	"strings"
+)
+
+// IncludeKey is the reserved top-level key which lists the
+// files an [Includer] should compose beneath a document.
+const IncludeKey = "include"
+
+// DefaultMaxIncludeDepth is the default maximum depth
+// of nested includes allowed by an [Includer].
+const DefaultMaxIncludeDepth = 10
+
+// IncludeOption represents options for configuring an [Includer].
+type IncludeOption func(*Includer)
+
+// MaxIncludeDepth limits how deeply includes may be nested.
+func MaxIncludeDepth(depth int) IncludeOption {
+	return func(inc *Includer) {
+		inc.maxDepth = depth
+	}
+}
+
+// Includer is a [Source] which composes config files referenced
+// by the reserved top-level [IncludeKey] of its underlying [Source].
+type Includer struct {
+	fsys     fs.FS
+	src      Source
+	maxDepth int
+}
+
+// AllowIncludes returns a [Source] which applies the given [Source] along with
+// any files it references via the reserved top-level [IncludeKey] e.g.
+//
+//	include: ["common/logging.yaml", "common/otel.json"]
+//
+// Included files are opened from the given [fs.FS] and their format is inferred from
+// their file extension. Included files are applied beneath the including document,
+// meaning values from the including document always win. Included files may themselves
+// include other files, up to a maximum depth.
+func AllowIncludes(fsys fs.FS, src Source, opts ...IncludeOption) Includer {
+	inc := Includer{
+		fsys:     fsys,
+		src:      src,
+		maxDepth: DefaultMaxIncludeDepth,
+	}
+	for _, opt := range opts {
+		opt(&inc)
+	}
+	return inc
+}
+
+// IncludeError occurs when an included file fails to be applied.
+type IncludeError struct {
+	Chain []string
+	Cause error
+}
+
+// Error implements the error interface.
+func (e IncludeError) Error() string {
+	return fmt.Sprintf("failed to include config file: %s: %s", strings.Join(e.Chain, " -> "), e.Cause)
+}
+
+// Unwrap implements the implicit interface used by errors.Is and errors.As.
+func (e IncludeError) Unwrap() error {
+	return e.Cause
+}
+
+// IncludeCycleError occurs when a file directly or indirectly includes itself.
+type IncludeCycleError struct {
+	Chain []string
+}
+
+// Error implements the error interface.
+func (e IncludeCycleError) Error() string {
+	return fmt.Sprintf("config include cycle detected: %s", strings.Join(e.Chain, " -> "))
+}
+
+// MaxIncludeDepthError occurs when includes are nested deeper than allowed.
+type MaxIncludeDepthError struct {
+	Chain    []string
+	MaxDepth int
+}
+
+// Error implements the error interface.
+func (e MaxIncludeDepthError) Error() string {
+	return fmt.Sprintf("config includes exceeded max depth of %d: %s", e.MaxDepth, strings.Join(e.Chain, " -> "))
+}
+
+// InvalidIncludeError occurs when the [IncludeKey] is not a list of file paths.
+type InvalidIncludeError struct {
+	Value any
+}
+
+// Error implements the error interface.
+func (e InvalidIncludeError) Error() string {
+	return fmt.Sprintf("expected config include to be a list of file paths: %v", e.Value)
+}
+
+// Apply implements the [Source] interface.
+func (inc Includer) Apply(store Store) error {
+	return inc.apply(store, inc.src, nil)
+}
+
+func (inc Includer) apply(store Store, src Source, chain []string) error {
+	m := make(Map)
+	err := src.Apply(m)
+	if err != nil {
+		return err
+	}
+
+	paths, err := includePaths(m)
+	if err != nil {
+		return err
+	}
+	delete(m, IncludeKey)
+
+	for _, p := range paths {
+		subChain := append(slices.Clip(chain), p)
+		if slices.Contains(chain, p) {
+			return IncludeCycleError{Chain: subChain}
+		}
+		if len(subChain) > inc.maxDepth {
+			return MaxIncludeDepthError{Chain: subChain, MaxDepth: inc.maxDepth}
+		}
+
+		err := inc.apply(store, FromFile(inc.fsys, p), subChain)
+		if err == nil {
+			continue
+		}
+		switch err.(type) {
+		case IncludeError, IncludeCycleError, MaxIncludeDepthError:
+			return err
+		default:
+			return IncludeError{Chain: subChain, Cause: err}
+		}
+	}
+
+	// The including document is applied last so its values win.
+	return m.Apply(store)
+}
+
+func includePaths(m Map) ([]string, error) {
+	v, ok := m[IncludeKey]
+	if !ok {
+		return nil, nil
+	}
+
+	switch x := v.(type) {
+	case string:
+		return []string{x}, nil
+	case []string:
+		return x, nil
+	case []any:
+		paths := make([]string, 0, len(x))
+		for _, p := range x {
+			s, ok := p.(string)
+			if !ok {
+				return nil, InvalidIncludeError{Value: v}
+			}
+			paths = append(paths, s)
+		}
+		return paths, nil
+	default:
+		return nil, InvalidIncludeError{Value: v}
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package config
+
+import (
+	"io/fs"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIncluder_Apply(t *testing.T) {
+	t.Run("will return an error", func(t *testing.T) {
+		t.Run("if an included file does not exist", func(t *testing.T) {
+			fsys := fstest.MapFS{}
+
+			src := AllowIncludes(fsys, FromYaml(strings.NewReader(`include: ["common.yaml"]`)))
+			err := src.Apply(make(Map))
+
+			var ierr IncludeError
+			if !assert.ErrorAs(t, err, &ierr) {
+				return
+			}
+			if !assert.NotEmpty(t, ierr.Error()) {
+				return
+			}
+			if !assert.Equal(t, []string{"common.yaml"}, ierr.Chain) {
+				return
+			}
+			if !assert.ErrorIs(t, err, fs.ErrNotExist) {
+				return
+			}
+		})
+
+		t.Run("if an included file has an unknown extension", func(t *testing.T) {
+			fsys := fstest.MapFS{
+				"common.toml": &fstest.MapFile{Data: []byte(`hello = "world"`)},
+			}
+
+			src := AllowIncludes(fsys, FromYaml(strings.NewReader(`include: ["common.toml"]`)))
+			err := src.Apply(make(Map))
+
+			var uerr UnknownFileExtensionError
+			if !assert.ErrorAs(t, err, &uerr) {
+				return
+			}
+			if !assert.NotEmpty(t, uerr.Error()) {
+				return
+			}
+		})
+
+		t.Run("if the include key is not a list of file paths", func(t *testing.T) {
+			src := AllowIncludes(fstest.MapFS{}, FromYaml(strings.NewReader(`include: [1, 2]`)))
+			err := src.Apply(make(Map))
+
+			var ierr InvalidIncludeError
+			if !assert.ErrorAs(t, err, &ierr) {
+				return
+			}
+			if !assert.NotEmpty(t, ierr.Error()) {
+				return
+			}
+		})
+
+		t.Run("if included files form a cycle", func(t *testing.T) {
+			fsys := fstest.MapFS{
+				"a.yaml": &fstest.MapFile{Data: []byte(`include: ["b.yaml"]`)},
+				"b.yaml": &fstest.MapFile{Data: []byte(`include: ["a.yaml"]`)},
+			}
+
+			src := AllowIncludes(fsys, FromYaml(strings.NewReader(`include: ["a.yaml"]`)))
+			err := src.Apply(make(Map))
+
+			var cerr IncludeCycleError
+			if !assert.ErrorAs(t, err, &cerr) {
+				return
+			}
+			if !assert.NotEmpty(t, cerr.Error()) {
+				return
+			}
+			if !assert.Equal(t, []string{"a.yaml", "b.yaml", "a.yaml"}, cerr.Chain) {
+				return
+			}
+		})
+
+		t.Run("if includes are nested deeper than the max depth", func(t *testing.T) {
+			fsys := fstest.MapFS{
+				"a.yaml": &fstest.MapFile{Data: []byte(`include: ["b.yaml"]`)},
+				"b.yaml": &fstest.MapFile{Data: []byte(`hello: world`)},
+			}
+
+			src := AllowIncludes(
+				fsys,
+				FromYaml(strings.NewReader(`include: ["a.yaml"]`)),
+				MaxIncludeDepth(1),
+			)
+			err := src.Apply(make(Map))
+
+			var derr MaxIncludeDepthError
+			if !assert.ErrorAs(t, err, &derr) {
+				return
+			}
+			if !assert.NotEmpty(t, derr.Error()) {
+				return
+			}
+		})
+	})
+
+	t.Run("will apply included files", func(t *testing.T) {
+		t.Run("if they are nested", func(t *testing.T) {
+			fsys := fstest.MapFS{
+				"common/logging.yaml": &fstest.MapFile{Data: []byte(`
+include: ["common/level.json"]
+logging:
+  format: json
+`)},
+				"common/level.json": &fstest.MapFile{Data: []byte(`{"logging": {"level": "info"}}`)},
+			}
+
+			src := AllowIncludes(fsys, FromYaml(strings.NewReader(`
+include: ["common/logging.yaml"]
+hello: world
+`)))
+
+			m, err := Read(src)
+			if !assert.Nil(t, err) {
+				return
+			}
+
+			var cfg struct {
+				Hello   string `config:"hello"`
+				Logging struct {
+					Format string `config:"format"`
+					Level  string `config:"level"`
+				} `config:"logging"`
+			}
+			err = m.Unmarshal(&cfg)
+			if !assert.Nil(t, err) {
+				return
+			}
+			if !assert.Equal(t, "world", cfg.Hello) {
+				return
+			}
+			if !assert.Equal(t, "json", cfg.Logging.Format) {
+				return
+			}
+			if !assert.Equal(t, "info", cfg.Logging.Level) {
+				return
+			}
+		})
+
+		t.Run("beneath the including document", func(t *testing.T) {
+			fsys := fstest.MapFS{
+				"a.yaml": &fstest.MapFile{Data: []byte(`
+hello: a
+name: a
+`)},
+				"b.yaml": &fstest.MapFile{Data: []byte(`
+name: b
+`)},
+			}
+
+			src := AllowIncludes(fsys, FromYaml(strings.NewReader(`
+include: ["a.yaml", "b.yaml"]
+hello: root
+`)))
+
+			m, err := Read(src)
+			if !assert.Nil(t, err) {
+				return
+			}
+
+			var cfg struct {
+				Hello   string   `config:"hello"`
+				Name    string   `config:"name"`
+				Include []string `config:"include"`
+			}
+			err = m.Unmarshal(&cfg)
+			if !assert.Nil(t, err) {
+				return
+			}
+			if !assert.Equal(t, "root", cfg.Hello) {
+				return
+			}
+			if !assert.Equal(t, "b", cfg.Name) {
+				return
+			}
+			if !assert.Empty(t, cfg.Include) {
+				return
+			}
+		})
+	})
+}
@@ -6,10 +6,12 @@
 package config
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"strconv"
 
 	"github.com/z5labs/bedrock/internal/ioutil"
 )
@@ -50,10 +52,67 @@ func (src Json) Apply(store Store) error {
 		return err
 	}
 
+	// Numbers are decoded as json.Number, instead of float64, so large
+	// integers e.g. math.MaxInt64 do not lose precision.
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
 	m := make(map[string]any)
-	err = json.Unmarshal(b, &m)
+	err = dec.Decode(&m)
+	if err != nil {
+		return InvalidJsonError{cause: err}
+	}
+	tok, err := dec.Token()
+	switch {
+	case err == io.EOF:
+	case err != nil:
+		return InvalidJsonError{cause: err}
+	default:
+		return InvalidJsonError{cause: fmt.Errorf("unexpected data after top-level value: %v", tok)}
+	}
+
+	_, err = normalizeJsonNumbers(m)
 	if err != nil {
 		return InvalidJsonError{cause: err}
 	}
 	return Map(m).Apply(store)
 }
+
+// normalizeJsonNumbers converts json.Numbers into the same Go types
+// the YAML source produces i.e. int, uint64 or float64.
+func normalizeJsonNumbers(v any) (any, error) {
+	switch x := v.(type) {
+	case map[string]any:
+		for k, v := range x {
+			n, err := normalizeJsonNumbers(v)
+			if err != nil {
+				return nil, err
+			}
+			x[k] = n
+		}
+		return x, nil
+	case []any:
+		for i, v := range x {
+			n, err := normalizeJsonNumbers(v)
+			if err != nil {
+				return nil, err
+			}
+			x[i] = n
+		}
+		return x, nil
+	case json.Number:
+		if i, err := strconv.ParseInt(string(x), 10, 0); err == nil {
+			return int(i), nil
+		}
+		if u, err := strconv.ParseUint(string(x), 10, 64); err == nil {
+			return u, nil
+		}
+		f, err := x.Float64()
+		if err != nil {
+			return nil, err
+		}
+		return f, nil
+	default:
+		return v, nil
+	}
+}
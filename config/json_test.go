@@ -7,6 +7,7 @@ package config
 
 import (
 	"errors"
+	"math"
 	"strings"
 	"testing"
 
@@ -56,6 +57,63 @@ func TestJson_Apply(t *testing.T) {
 			}
 		})
 
+		t.Run("if the io.Reader contains data after the JSON object", func(t *testing.T) {
+			r := strings.NewReader(`{"hello": "world"} hello`)
+
+			store := storeFunc(func(k key.Keyer, a any) error {
+				return nil
+			})
+
+			src := FromJson(r)
+			err := src.Apply(store)
+
+			var ierr InvalidJsonError
+			if !assert.ErrorAs(t, err, &ierr) {
+				return
+			}
+		})
+
+		t.Run("if the io.Reader contains another JSON value after the JSON object", func(t *testing.T) {
+			r := strings.NewReader(`{"hello": "world"} {"hello": "world"}`)
+
+			store := storeFunc(func(k key.Keyer, a any) error {
+				return nil
+			})
+
+			src := FromJson(r)
+			err := src.Apply(store)
+
+			var ierr InvalidJsonError
+			if !assert.ErrorAs(t, err, &ierr) {
+				return
+			}
+			if !assert.NotContains(t, ierr.Error(), "<nil>") {
+				return
+			}
+			if !assert.Contains(t, ierr.Error(), "{") {
+				return
+			}
+		})
+
+		t.Run("if the io.Reader contains a number which can not be represented", func(t *testing.T) {
+			r := strings.NewReader(`{"n": 1e400}`)
+
+			store := storeFunc(func(k key.Keyer, a any) error {
+				return nil
+			})
+
+			src := FromJson(r)
+			err := src.Apply(store)
+
+			var ierr InvalidJsonError
+			if !assert.ErrorAs(t, err, &ierr) {
+				return
+			}
+			if !assert.NotNil(t, ierr.Unwrap()) {
+				return
+			}
+		})
+
 		t.Run("if the underlying store fails to set a key", func(t *testing.T) {
 			r := strings.NewReader(`{"hello": "world"}`)
 
@@ -71,4 +129,56 @@ func TestJson_Apply(t *testing.T) {
 			}
 		})
 	})
+
+	t.Run("will preserve precision", func(t *testing.T) {
+		testCases := []struct {
+			Name  string
+			Json  string
+			Value any
+		}{
+			{
+				Name:  "if the value is a negative integer",
+				Json:  `{"n": -42}`,
+				Value: -42,
+			},
+			{
+				Name:  "if the value is math.MaxInt64",
+				Json:  `{"n": 9223372036854775807}`,
+				Value: math.MaxInt64,
+			},
+			{
+				Name:  "if the value is math.MaxUint64",
+				Json:  `{"n": 18446744073709551615}`,
+				Value: uint64(math.MaxUint64),
+			},
+			{
+				Name:  "if the value is a float",
+				Json:  `{"n": 1.5}`,
+				Value: 1.5,
+			},
+			{
+				Name:  "if the value is in scientific notation",
+				Json:  `{"n": 1e3}`,
+				Value: float64(1000),
+			},
+			{
+				Name:  "if the value is nested in a list",
+				Json:  `{"n": [9223372036854775807]}`,
+				Value: []any{math.MaxInt64},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.Name, func(t *testing.T) {
+				m := make(Map)
+				err := FromJson(strings.NewReader(testCase.Json)).Apply(m)
+				if !assert.Nil(t, err) {
+					return
+				}
+				if !assert.Equal(t, testCase.Value, m["n"]) {
+					return
+				}
+			})
+		}
+	})
 }
@@ -0,0 +1,98 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package config
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// ProfilesKey is the reserved top-level key which
+// contains the profiles a [ProfileSelector] selects from.
+const ProfilesKey = "profiles"
+
+// ProfileSelector is a [Source] which overlays a named profile onto the root config.
+type ProfileSelector struct {
+	name string
+	srcs []Source
+}
+
+// SelectProfile returns a [Source] which applies the given sources, in order, and
+// then overlays the values under profiles.<name> onto the root of the merged result e.g.
+//
+//	log_level: info
+//	profiles:
+//	  dev:
+//	    log_level: debug
+//
+// The reserved [ProfilesKey] is always removed from the result. If name is
+// empty then no profile is overlaid and only the shared defaults are applied.
+func SelectProfile(name string, srcs ...Source) ProfileSelector {
+	return ProfileSelector{
+		name: name,
+		srcs: srcs,
+	}
+}
+
+// UnknownProfileError occurs when the selected profile is not defined.
+type UnknownProfileError struct {
+	Name      string
+	Available []string
+}
+
+// Error implements the error interface.
+func (e UnknownProfileError) Error() string {
+	return fmt.Sprintf("unknown config profile: %s: available profiles: [%s]", e.Name, strings.Join(e.Available, ", "))
+}
+
+// Apply implements the [Source] interface.
+func (src ProfileSelector) Apply(store Store) error {
+	m := make(Map)
+	for _, s := range src.srcs {
+		err := s.Apply(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	v, ok := m[ProfilesKey]
+	delete(m, ProfilesKey)
+
+	profiles, isMap := v.(map[string]any)
+	if ok && !isMap {
+		return UnexpectedKeyValueTypeError{
+			Key:          ProfilesKey,
+			ExpectedType: "map[string]any",
+		}
+	}
+	if src.name == "" {
+		return m.Apply(store)
+	}
+
+	profile, ok := profiles[src.name]
+	if !ok {
+		return UnknownProfileError{
+			Name:      src.name,
+			Available: slices.Sorted(maps.Keys(profiles)),
+		}
+	}
+
+	overlay, ok := profile.(map[string]any)
+	if !ok {
+		return UnexpectedKeyValueTypeError{
+			Key:          ProfilesKey + "." + src.name,
+			ExpectedType: "map[string]any",
+		}
+	}
+
+	err := Map(overlay).Apply(m)
+	if err != nil {
+		return err
+	}
+	return m.Apply(store)
+}
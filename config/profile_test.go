@@ -0,0 +1,121 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const profilesYaml = `
+log_level: info
+server:
+  host: localhost
+  port: 8080
+profiles:
+  dev:
+    log_level: debug
+  prod:
+    server:
+      host: example.com
+`
+
+type profileConfig struct {
+	LogLevel string `config:"log_level"`
+	Server   struct {
+		Host string `config:"host"`
+		Port int    `config:"port"`
+	} `config:"server"`
+	Profiles map[string]any `config:"profiles"`
+}
+
+func TestProfileSelector_Apply(t *testing.T) {
+	t.Run("will return an error", func(t *testing.T) {
+		t.Run("if the selected profile does not exist", func(t *testing.T) {
+			src := SelectProfile("staging", FromYaml(strings.NewReader(profilesYaml)))
+
+			err := src.Apply(make(Map))
+
+			var perr UnknownProfileError
+			if !assert.ErrorAs(t, err, &perr) {
+				return
+			}
+			if !assert.NotEmpty(t, perr.Error()) {
+				return
+			}
+			if !assert.Equal(t, []string{"dev", "prod"}, perr.Available) {
+				return
+			}
+		})
+
+		t.Run("if the profiles key is not a map", func(t *testing.T) {
+			src := SelectProfile("dev", FromYaml(strings.NewReader(`profiles: [dev]`)))
+
+			err := src.Apply(make(Map))
+
+			var uerr UnexpectedKeyValueTypeError
+			if !assert.ErrorAs(t, err, &uerr) {
+				return
+			}
+		})
+	})
+
+	t.Run("will apply the config", func(t *testing.T) {
+		testCases := []struct {
+			Name     string
+			Profile  string
+			LogLevel string
+			Host     string
+		}{
+			{
+				Name:     "if no profile is selected",
+				LogLevel: "info",
+				Host:     "localhost",
+			},
+			{
+				Name:     "if the selected profile overrides a top-level key",
+				Profile:  "dev",
+				LogLevel: "debug",
+				Host:     "localhost",
+			},
+			{
+				Name:     "if the selected profile overrides a nested key",
+				Profile:  "prod",
+				LogLevel: "info",
+				Host:     "example.com",
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.Name, func(t *testing.T) {
+				m, err := Read(SelectProfile(testCase.Profile, FromYaml(strings.NewReader(profilesYaml))))
+				if !assert.Nil(t, err) {
+					return
+				}
+
+				var cfg profileConfig
+				err = m.Unmarshal(&cfg)
+				if !assert.Nil(t, err) {
+					return
+				}
+				if !assert.Equal(t, testCase.LogLevel, cfg.LogLevel) {
+					return
+				}
+				if !assert.Equal(t, testCase.Host, cfg.Server.Host) {
+					return
+				}
+				if !assert.Equal(t, 8080, cfg.Server.Port) {
+					return
+				}
+				if !assert.Empty(t, cfg.Profiles) {
+					return
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var refPattern = regexp.MustCompile(`\$\{ref:([^}]+)\}`)
+
+// RefResolver is a [Source] which resolves references between config keys.
+type RefResolver struct {
+	srcs []Source
+}
+
+// ResolveRefs returns a [Source] which applies the given sources, in order, and
+// then resolves references of the form ${ref:key.path} in string values against
+// the merged result e.g.
+//
+//	endpoints:
+//	  base: https://example.com
+//	api_url: ${ref:endpoints.base}/v2
+//
+// Since resolution happens after all the given sources have been applied, a later
+// source can override a referenced value once and every reference will see it.
+// If a string value consists of only a single reference, the referenced value
+// is substituted as is, otherwise it is formatted into the string.
+func ResolveRefs(srcs ...Source) RefResolver {
+	return RefResolver{srcs: srcs}
+}
+
+// MissingRefError occurs when a config value references a key which does not exist.
+type MissingRefError struct {
+	Key string
+	Ref string
+}
+
+// Error implements the error interface.
+func (e MissingRefError) Error() string {
+	return fmt.Sprintf("config key %s references missing key: %s", e.Key, e.Ref)
+}
+
+// RefCycleError occurs when config values directly or indirectly reference themselves.
+type RefCycleError struct {
+	Chain []string
+}
+
+// Error implements the error interface.
+func (e RefCycleError) Error() string {
+	return fmt.Sprintf("config reference cycle detected: %s", strings.Join(e.Chain, " -> "))
+}
+
+// Apply implements the [Source] interface.
+func (src RefResolver) Apply(store Store) error {
+	m := make(Map)
+	for _, s := range src.srcs {
+		err := s.Apply(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	r := &refResolver{root: m}
+	err := r.resolveMap("", m)
+	if err != nil {
+		return err
+	}
+	return m.Apply(store)
+}
+
+type refResolver struct {
+	root     map[string]any
+	visiting []string
+}
+
+func (r *refResolver) resolveMap(prefix string, m map[string]any) error {
+	for k, v := range m {
+		v, err := r.resolve(joinKey(prefix, k), v)
+		if err != nil {
+			return err
+		}
+		m[k] = v
+	}
+	return nil
+}
+
+func (r *refResolver) resolve(k string, v any) (any, error) {
+	switch x := v.(type) {
+	case map[string]any:
+		return x, r.resolveMap(k, x)
+	case []any:
+		for i, elem := range x {
+			elem, err := r.resolve(k+"["+strconv.Itoa(i)+"]", elem)
+			if err != nil {
+				return nil, err
+			}
+			x[i] = elem
+		}
+		return x, nil
+	case string:
+		return r.resolveString(k, x)
+	default:
+		return v, nil
+	}
+}
+
+func (r *refResolver) resolveString(k, s string) (any, error) {
+	matches := refPattern.FindAllStringSubmatchIndex(s, -1)
+	if len(matches) == 0 {
+		return s, nil
+	}
+	if slices.Contains(r.visiting, k) {
+		return nil, RefCycleError{Chain: append(slices.Clone(r.visiting), k)}
+	}
+	r.visiting = append(r.visiting, k)
+	defer func() {
+		r.visiting = r.visiting[:len(r.visiting)-1]
+	}()
+
+	var sb strings.Builder
+	last := 0
+	for _, match := range matches {
+		ref := s[match[2]:match[3]]
+		parent, leaf, ok, err := r.lookup(ref)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, MissingRefError{Key: k, Ref: ref}
+		}
+
+		// Resolve the referenced value first so chained references work
+		// and store it back so it is only ever resolved once.
+		v, err := r.resolve(ref, parent[leaf])
+		if err != nil {
+			return nil, err
+		}
+		parent[leaf] = v
+
+		if len(matches) == 1 && match[0] == 0 && match[1] == len(s) {
+			return v, nil
+		}
+
+		sb.WriteString(s[last:match[0]])
+		fmt.Fprint(&sb, v)
+		last = match[1]
+	}
+	sb.WriteString(s[last:])
+	return sb.String(), nil
+}
+
+// lookup finds the map containing the given key. Any string values along
+// the way are resolved first since they may be references to maps, which
+// would otherwise only be resolved if they happened to be visited earlier.
+func (r *refResolver) lookup(k string) (map[string]any, string, bool, error) {
+	m := r.root
+	names := strings.Split(k, ".")
+	for i, name := range names[:len(names)-1] {
+		v, ok := m[name]
+		if !ok {
+			return nil, "", false, nil
+		}
+		if s, ok := v.(string); ok {
+			resolved, err := r.resolveString(strings.Join(names[:i+1], "."), s)
+			if err != nil {
+				return nil, "", false, err
+			}
+			m[name] = resolved
+			v = resolved
+		}
+
+		sub, ok := v.(map[string]any)
+		if !ok {
+			return nil, "", false, nil
+		}
+		m = sub
+	}
+	leaf := names[len(names)-1]
+	_, ok := m[leaf]
+	return m, leaf, ok, nil
+}
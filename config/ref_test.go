@@ -0,0 +1,181 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package config
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefResolver_Apply(t *testing.T) {
+	t.Run("will return an error", func(t *testing.T) {
+		t.Run("if one of the Sources fails to apply itself", func(t *testing.T) {
+			srcErr := errors.New("failed to apply config")
+			src := sourceFunc(func(s Store) error {
+				return srcErr
+			})
+
+			err := ResolveRefs(src).Apply(make(Map))
+			if !assert.ErrorIs(t, err, srcErr) {
+				return
+			}
+		})
+
+		t.Run("if a referenced key is missing", func(t *testing.T) {
+			src := FromYaml(strings.NewReader(`api_url: ${ref:endpoints.base}/v2`))
+
+			err := ResolveRefs(src).Apply(make(Map))
+
+			var merr MissingRefError
+			if !assert.ErrorAs(t, err, &merr) {
+				return
+			}
+			if !assert.NotEmpty(t, merr.Error()) {
+				return
+			}
+			if !assert.Equal(t, "api_url", merr.Key) {
+				return
+			}
+			if !assert.Equal(t, "endpoints.base", merr.Ref) {
+				return
+			}
+		})
+
+		t.Run("if references form a cycle", func(t *testing.T) {
+			src := FromYaml(strings.NewReader(`
+a: ${ref:b}
+b: x${ref:c}
+c: ${ref:a}
+`))
+
+			err := ResolveRefs(src).Apply(make(Map))
+
+			var cerr RefCycleError
+			if !assert.ErrorAs(t, err, &cerr) {
+				return
+			}
+			if !assert.NotEmpty(t, cerr.Error()) {
+				return
+			}
+			if !assert.Len(t, cerr.Chain, 4) {
+				return
+			}
+			if !assert.Equal(t, cerr.Chain[0], cerr.Chain[3]) {
+				return
+			}
+		})
+	})
+
+	t.Run("will resolve references", func(t *testing.T) {
+		t.Run("if they are chained", func(t *testing.T) {
+			src := FromYaml(strings.NewReader(`
+host: example.com
+base: https://${ref:host}
+api_url: ${ref:base}/v2
+`))
+
+			m, err := Read(ResolveRefs(src))
+			if !assert.Nil(t, err) {
+				return
+			}
+
+			var cfg struct {
+				ApiUrl string `config:"api_url"`
+			}
+			err = m.Unmarshal(&cfg)
+			if !assert.Nil(t, err) {
+				return
+			}
+			if !assert.Equal(t, "https://example.com/v2", cfg.ApiUrl) {
+				return
+			}
+		})
+
+		t.Run("if the referenced value is overridden by a later source", func(t *testing.T) {
+			base := FromYaml(strings.NewReader(`
+endpoints:
+  base: https://example.com
+api_url: ${ref:endpoints.base}/v2
+`))
+			overlay := FromYaml(strings.NewReader(`
+endpoints:
+  base: https://staging.example.com
+`))
+
+			m, err := Read(ResolveRefs(base, overlay))
+			if !assert.Nil(t, err) {
+				return
+			}
+
+			var cfg struct {
+				ApiUrl string `config:"api_url"`
+			}
+			err = m.Unmarshal(&cfg)
+			if !assert.Nil(t, err) {
+				return
+			}
+			if !assert.Equal(t, "https://staging.example.com/v2", cfg.ApiUrl) {
+				return
+			}
+		})
+
+		t.Run("if the referenced key is nested under a referenced value", func(t *testing.T) {
+			// Map iteration order is random so repeat to make sure
+			// resolution does not depend on which key is visited first.
+			for range 20 {
+				src := FromYaml(strings.NewReader(`
+defaults:
+  base: https://example.com
+endpoints: ${ref:defaults}
+api: ${ref:endpoints.base}/v2
+`))
+
+				m, err := Read(ResolveRefs(src))
+				if !assert.Nil(t, err) {
+					return
+				}
+
+				var cfg struct {
+					Api string `config:"api"`
+				}
+				err = m.Unmarshal(&cfg)
+				if !assert.Nil(t, err) {
+					return
+				}
+				if !assert.Equal(t, "https://example.com/v2", cfg.Api) {
+					return
+				}
+			}
+		})
+
+		t.Run("if the value is only a reference to a non-string value", func(t *testing.T) {
+			src := FromYaml(strings.NewReader(`
+defaults:
+  port: 8080
+ports: ["${ref:defaults.port}"]
+`))
+
+			m, err := Read(ResolveRefs(src))
+			if !assert.Nil(t, err) {
+				return
+			}
+
+			var cfg struct {
+				Ports []int `config:"ports"`
+			}
+			err = m.Unmarshal(&cfg)
+			if !assert.Nil(t, err) {
+				return
+			}
+			if !assert.Equal(t, []int{8080}, cfg.Ports) {
+				return
+			}
+		})
+	})
+}
@@ -51,6 +51,12 @@ type TextTemplateRenderer struct {
 }
 
 // RenderTextTemplate configures a TextTemplateRenderer.
+//
+// Templating is opt-in per io.Reader, which matters when merging config
+// from sources you do not control. Any function registered with [TemplateFunc]
+// e.g. an "env" func backed by [os.Getenv], can be invoked by whoever authored
+// the template, so only render trusted input and pass untrusted sources, such
+// as a tenant supplied overlay, directly to [FromYaml] or [FromJson] instead.
 func RenderTextTemplate(r io.Reader, opts ...RenderTextTemplateOption) *TextTemplateRenderer {
 	ttr := &TextTemplateRenderer{
 		r:     r,
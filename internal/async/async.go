@@ -0,0 +1,26 @@
+// Copyright (c) 2024 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package async
+
+import "github.com/z5labs/bedrock"
+
+// Go calls f in a new goroutine and sends its result on the returned channel.
+// The channel is buffered so the goroutine is never left blocked if the caller
+// stops waiting on it. Any panic raised by f can not propagate to the caller
+// so it is recovered and sent as a [bedrock.PanicError] instead.
+func Go(f func() error) <-chan error {
+	errCh := make(chan error, 1)
+	go func() {
+		var err error
+		defer func() {
+			errCh <- err
+		}()
+		defer bedrock.Recover(&err)
+
+		err = f()
+	}()
+	return errCh
+}